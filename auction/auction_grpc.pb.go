@@ -19,126 +19,868 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Auction_Bid_FullMethodName    = "/auction.Auction/Bid"
-	Auction_Result_FullMethodName = "/auction.Auction/Result"
+	Authentication_Register_FullMethodName = "/auction.Authentication/Register"
+	Authentication_Login_FullMethodName    = "/auction.Authentication/Login"
+)
+
+// AuthenticationClient is the client API for Authentication service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthenticationClient interface {
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+}
+
+type authenticationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthenticationClient(cc grpc.ClientConnInterface) AuthenticationClient {
+	return &authenticationClient{cc}
+}
+
+func (c *authenticationClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, Authentication_Register_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, Authentication_Login_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthenticationServer is the server API for Authentication service.
+// All implementations must embed UnimplementedAuthenticationServer
+// for forward compatibility
+type AuthenticationServer interface {
+	Register(context.Context, *RegisterRequest) (*TokenResponse, error)
+	Login(context.Context, *LoginRequest) (*TokenResponse, error)
+	mustEmbedUnimplementedAuthenticationServer()
+}
+
+// UnimplementedAuthenticationServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthenticationServer struct {
+}
+
+func (UnimplementedAuthenticationServer) Register(context.Context, *RegisterRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedAuthenticationServer) Login(context.Context, *LoginRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedAuthenticationServer) mustEmbedUnimplementedAuthenticationServer() {}
+
+// UnsafeAuthenticationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthenticationServer will
+// result in compilation errors.
+type UnsafeAuthenticationServer interface {
+	mustEmbedUnimplementedAuthenticationServer()
+}
+
+func RegisterAuthenticationServer(s grpc.ServiceRegistrar, srv AuthenticationServer) {
+	s.RegisterService(&Authentication_ServiceDesc, srv)
+}
+
+func _Authentication_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authentication_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Authentication_ServiceDesc is the grpc.ServiceDesc for Authentication service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Authentication_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auction.Authentication",
+	HandlerType: (*AuthenticationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _Authentication_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Authentication_Login_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auction.proto",
+}
+
+const (
+	Auction_Bid_FullMethodName           = "/auction.Auction/Bid"
+	Auction_Result_FullMethodName        = "/auction.Auction/Result"
+	Auction_CreateAuction_FullMethodName = "/auction.Auction/CreateAuction"
+	Auction_ListAuctions_FullMethodName  = "/auction.Auction/ListAuctions"
+	Auction_CloseAuction_FullMethodName  = "/auction.Auction/CloseAuction"
+	Auction_StartAuction_FullMethodName  = "/auction.Auction/StartAuction"
+	Auction_WatchAuction_FullMethodName  = "/auction.Auction/WatchAuction"
+	Auction_Accept_FullMethodName        = "/auction.Auction/Accept"
+	Auction_BidHistory_FullMethodName    = "/auction.Auction/BidHistory"
+	Auction_Ledger_FullMethodName        = "/auction.Auction/Ledger"
+	Auction_Commit_FullMethodName        = "/auction.Auction/Commit"
+	Auction_Reveal_FullMethodName        = "/auction.Auction/Reveal"
 )
 
 // AuctionClient is the client API for Auction service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type AuctionClient interface {
-	Bid(ctx context.Context, in *BidRequest, opts ...grpc.CallOption) (*BidResponse, error)
-	Result(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error)
+type AuctionClient interface {
+	Bid(ctx context.Context, in *BidRequest, opts ...grpc.CallOption) (*BidResponse, error)
+	Result(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error)
+	CreateAuction(ctx context.Context, in *CreateAuctionRequest, opts ...grpc.CallOption) (*CreateAuctionResponse, error)
+	ListAuctions(ctx context.Context, in *ListAuctionsRequest, opts ...grpc.CallOption) (*ListAuctionsResponse, error)
+	CloseAuction(ctx context.Context, in *CloseAuctionRequest, opts ...grpc.CallOption) (*CloseAuctionResponse, error)
+	StartAuction(ctx context.Context, in *StartAuctionRequest, opts ...grpc.CallOption) (*StartAuctionResponse, error)
+	WatchAuction(ctx context.Context, in *WatchAuctionRequest, opts ...grpc.CallOption) (Auction_WatchAuctionClient, error)
+	Accept(ctx context.Context, in *AcceptRequest, opts ...grpc.CallOption) (*AcceptResponse, error)
+	BidHistory(ctx context.Context, in *BidHistoryRequest, opts ...grpc.CallOption) (*BidHistoryResponse, error)
+	Ledger(ctx context.Context, in *LedgerRequest, opts ...grpc.CallOption) (*LedgerResponse, error)
+	Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*BidResponse, error)
+	Reveal(ctx context.Context, in *RevealRequest, opts ...grpc.CallOption) (*BidResponse, error)
+}
+
+type auctionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuctionClient(cc grpc.ClientConnInterface) AuctionClient {
+	return &auctionClient{cc}
+}
+
+func (c *auctionClient) Bid(ctx context.Context, in *BidRequest, opts ...grpc.CallOption) (*BidResponse, error) {
+	out := new(BidResponse)
+	err := c.cc.Invoke(ctx, Auction_Bid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) Result(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error) {
+	out := new(ResultResponse)
+	err := c.cc.Invoke(ctx, Auction_Result_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) CreateAuction(ctx context.Context, in *CreateAuctionRequest, opts ...grpc.CallOption) (*CreateAuctionResponse, error) {
+	out := new(CreateAuctionResponse)
+	err := c.cc.Invoke(ctx, Auction_CreateAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) ListAuctions(ctx context.Context, in *ListAuctionsRequest, opts ...grpc.CallOption) (*ListAuctionsResponse, error) {
+	out := new(ListAuctionsResponse)
+	err := c.cc.Invoke(ctx, Auction_ListAuctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) CloseAuction(ctx context.Context, in *CloseAuctionRequest, opts ...grpc.CallOption) (*CloseAuctionResponse, error) {
+	out := new(CloseAuctionResponse)
+	err := c.cc.Invoke(ctx, Auction_CloseAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) StartAuction(ctx context.Context, in *StartAuctionRequest, opts ...grpc.CallOption) (*StartAuctionResponse, error) {
+	out := new(StartAuctionResponse)
+	err := c.cc.Invoke(ctx, Auction_StartAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) WatchAuction(ctx context.Context, in *WatchAuctionRequest, opts ...grpc.CallOption) (Auction_WatchAuctionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Auction_ServiceDesc.Streams[0], Auction_WatchAuction_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &auctionWatchAuctionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Auction_WatchAuctionClient interface {
+	Recv() (*ResultResponse, error)
+	grpc.ClientStream
+}
+
+type auctionWatchAuctionClient struct {
+	grpc.ClientStream
+}
+
+func (x *auctionWatchAuctionClient) Recv() (*ResultResponse, error) {
+	m := new(ResultResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *auctionClient) Accept(ctx context.Context, in *AcceptRequest, opts ...grpc.CallOption) (*AcceptResponse, error) {
+	out := new(AcceptResponse)
+	err := c.cc.Invoke(ctx, Auction_Accept_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) BidHistory(ctx context.Context, in *BidHistoryRequest, opts ...grpc.CallOption) (*BidHistoryResponse, error) {
+	out := new(BidHistoryResponse)
+	err := c.cc.Invoke(ctx, Auction_BidHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) Ledger(ctx context.Context, in *LedgerRequest, opts ...grpc.CallOption) (*LedgerResponse, error) {
+	out := new(LedgerResponse)
+	err := c.cc.Invoke(ctx, Auction_Ledger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) Commit(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*BidResponse, error) {
+	out := new(BidResponse)
+	err := c.cc.Invoke(ctx, Auction_Commit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionClient) Reveal(ctx context.Context, in *RevealRequest, opts ...grpc.CallOption) (*BidResponse, error) {
+	out := new(BidResponse)
+	err := c.cc.Invoke(ctx, Auction_Reveal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuctionServer is the server API for Auction service.
+// All implementations must embed UnimplementedAuctionServer
+// for forward compatibility
+type AuctionServer interface {
+	Bid(context.Context, *BidRequest) (*BidResponse, error)
+	Result(context.Context, *ResultRequest) (*ResultResponse, error)
+	CreateAuction(context.Context, *CreateAuctionRequest) (*CreateAuctionResponse, error)
+	ListAuctions(context.Context, *ListAuctionsRequest) (*ListAuctionsResponse, error)
+	CloseAuction(context.Context, *CloseAuctionRequest) (*CloseAuctionResponse, error)
+	StartAuction(context.Context, *StartAuctionRequest) (*StartAuctionResponse, error)
+	WatchAuction(*WatchAuctionRequest, Auction_WatchAuctionServer) error
+	Accept(context.Context, *AcceptRequest) (*AcceptResponse, error)
+	BidHistory(context.Context, *BidHistoryRequest) (*BidHistoryResponse, error)
+	Ledger(context.Context, *LedgerRequest) (*LedgerResponse, error)
+	Commit(context.Context, *CommitRequest) (*BidResponse, error)
+	Reveal(context.Context, *RevealRequest) (*BidResponse, error)
+	mustEmbedUnimplementedAuctionServer()
+}
+
+// UnimplementedAuctionServer must be embedded to have forward compatible implementations.
+type UnimplementedAuctionServer struct {
+}
+
+func (UnimplementedAuctionServer) Bid(context.Context, *BidRequest) (*BidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bid not implemented")
+}
+func (UnimplementedAuctionServer) Result(context.Context, *ResultRequest) (*ResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Result not implemented")
+}
+func (UnimplementedAuctionServer) CreateAuction(context.Context, *CreateAuctionRequest) (*CreateAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuction not implemented")
+}
+func (UnimplementedAuctionServer) ListAuctions(context.Context, *ListAuctionsRequest) (*ListAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuctions not implemented")
+}
+func (UnimplementedAuctionServer) CloseAuction(context.Context, *CloseAuctionRequest) (*CloseAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAuction not implemented")
+}
+func (UnimplementedAuctionServer) StartAuction(context.Context, *StartAuctionRequest) (*StartAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAuction not implemented")
+}
+func (UnimplementedAuctionServer) WatchAuction(*WatchAuctionRequest, Auction_WatchAuctionServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAuction not implemented")
+}
+func (UnimplementedAuctionServer) Accept(context.Context, *AcceptRequest) (*AcceptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accept not implemented")
+}
+func (UnimplementedAuctionServer) BidHistory(context.Context, *BidHistoryRequest) (*BidHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidHistory not implemented")
+}
+func (UnimplementedAuctionServer) Ledger(context.Context, *LedgerRequest) (*LedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ledger not implemented")
+}
+func (UnimplementedAuctionServer) Commit(context.Context, *CommitRequest) (*BidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (UnimplementedAuctionServer) Reveal(context.Context, *RevealRequest) (*BidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reveal not implemented")
+}
+func (UnimplementedAuctionServer) mustEmbedUnimplementedAuctionServer() {}
+
+// UnsafeAuctionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuctionServer will
+// result in compilation errors.
+type UnsafeAuctionServer interface {
+	mustEmbedUnimplementedAuctionServer()
+}
+
+func RegisterAuctionServer(s grpc.ServiceRegistrar, srv AuctionServer) {
+	s.RegisterService(&Auction_ServiceDesc, srv)
+}
+
+func _Auction_Bid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).Bid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_Bid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).Bid(ctx, req.(*BidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_Result_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).Result(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_Result_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).Result(ctx, req.(*ResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_CreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).CreateAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_CreateAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).CreateAuction(ctx, req.(*CreateAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_ListAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).ListAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_ListAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).ListAuctions(ctx, req.(*ListAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_CloseAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).CloseAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_CloseAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).CloseAuction(ctx, req.(*CloseAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_StartAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).StartAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_StartAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).StartAuction(ctx, req.(*StartAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_WatchAuction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAuctionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuctionServer).WatchAuction(m, &auctionWatchAuctionServer{stream})
+}
+
+type Auction_WatchAuctionServer interface {
+	Send(*ResultResponse) error
+	grpc.ServerStream
+}
+
+type auctionWatchAuctionServer struct {
+	grpc.ServerStream
+}
+
+func (x *auctionWatchAuctionServer) Send(m *ResultResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Auction_Accept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).Accept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_Accept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).Accept(ctx, req.(*AcceptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_BidHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BidHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).BidHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_BidHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).BidHistory(ctx, req.(*BidHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_Ledger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).Ledger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_Ledger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).Ledger(ctx, req.(*LedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_Commit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).Commit(ctx, req.(*CommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auction_Reveal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServer).Reveal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auction_Reveal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServer).Reveal(ctx, req.(*RevealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Auction_ServiceDesc is the grpc.ServiceDesc for Auction service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Auction_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auction.Auction",
+	HandlerType: (*AuctionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Bid",
+			Handler:    _Auction_Bid_Handler,
+		},
+		{
+			MethodName: "Result",
+			Handler:    _Auction_Result_Handler,
+		},
+		{
+			MethodName: "CreateAuction",
+			Handler:    _Auction_CreateAuction_Handler,
+		},
+		{
+			MethodName: "ListAuctions",
+			Handler:    _Auction_ListAuctions_Handler,
+		},
+		{
+			MethodName: "CloseAuction",
+			Handler:    _Auction_CloseAuction_Handler,
+		},
+		{
+			MethodName: "StartAuction",
+			Handler:    _Auction_StartAuction_Handler,
+		},
+		{
+			MethodName: "Accept",
+			Handler:    _Auction_Accept_Handler,
+		},
+		{
+			MethodName: "BidHistory",
+			Handler:    _Auction_BidHistory_Handler,
+		},
+		{
+			MethodName: "Ledger",
+			Handler:    _Auction_Ledger_Handler,
+		},
+		{
+			MethodName: "Commit",
+			Handler:    _Auction_Commit_Handler,
+		},
+		{
+			MethodName: "Reveal",
+			Handler:    _Auction_Reveal_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAuction",
+			Handler:       _Auction_WatchAuction_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "auction.proto",
+}
+
+const (
+	Election_Election_FullMethodName = "/auction.Election/Election"
+)
+
+// ElectionClient is the client API for Election service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ElectionClient interface {
+	Election(ctx context.Context, in *ElectionMessage, opts ...grpc.CallOption) (*VoteResponse, error)
+}
+
+type electionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewElectionClient(cc grpc.ClientConnInterface) ElectionClient {
+	return &electionClient{cc}
+}
+
+func (c *electionClient) Election(ctx context.Context, in *ElectionMessage, opts ...grpc.CallOption) (*VoteResponse, error) {
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, Election_Election_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ElectionServer is the server API for Election service.
+// All implementations must embed UnimplementedElectionServer
+// for forward compatibility
+type ElectionServer interface {
+	Election(context.Context, *ElectionMessage) (*VoteResponse, error)
+	mustEmbedUnimplementedElectionServer()
+}
+
+// UnimplementedElectionServer must be embedded to have forward compatible implementations.
+type UnimplementedElectionServer struct {
+}
+
+func (UnimplementedElectionServer) Election(context.Context, *ElectionMessage) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Election not implemented")
+}
+func (UnimplementedElectionServer) mustEmbedUnimplementedElectionServer() {}
+
+// UnsafeElectionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ElectionServer will
+// result in compilation errors.
+type UnsafeElectionServer interface {
+	mustEmbedUnimplementedElectionServer()
+}
+
+func RegisterElectionServer(s grpc.ServiceRegistrar, srv ElectionServer) {
+	s.RegisterService(&Election_ServiceDesc, srv)
+}
+
+func _Election_Election_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElectionMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ElectionServer).Election(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Election_Election_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ElectionServer).Election(ctx, req.(*ElectionMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Election_ServiceDesc is the grpc.ServiceDesc for Election service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Election_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auction.Election",
+	HandlerType: (*ElectionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Election",
+			Handler:    _Election_Election_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auction.proto",
+}
+
+const (
+	Replication_AppendEntries_FullMethodName = "/auction.Replication/AppendEntries"
+	Replication_CatchUp_FullMethodName       = "/auction.Replication/CatchUp"
+)
+
+// ReplicationClient is the client API for Replication service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReplicationClient interface {
+	AppendEntries(ctx context.Context, in *AppendEntriesMessage, opts ...grpc.CallOption) (*AppendEntriesResponse, error)
+	CatchUp(ctx context.Context, in *CatchUpRequest, opts ...grpc.CallOption) (*CatchUpResponse, error)
 }
 
-type auctionClient struct {
+type replicationClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewAuctionClient(cc grpc.ClientConnInterface) AuctionClient {
-	return &auctionClient{cc}
+func NewReplicationClient(cc grpc.ClientConnInterface) ReplicationClient {
+	return &replicationClient{cc}
 }
 
-func (c *auctionClient) Bid(ctx context.Context, in *BidRequest, opts ...grpc.CallOption) (*BidResponse, error) {
-	out := new(BidResponse)
-	err := c.cc.Invoke(ctx, Auction_Bid_FullMethodName, in, out, opts...)
+func (c *replicationClient) AppendEntries(ctx context.Context, in *AppendEntriesMessage, opts ...grpc.CallOption) (*AppendEntriesResponse, error) {
+	out := new(AppendEntriesResponse)
+	err := c.cc.Invoke(ctx, Replication_AppendEntries_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *auctionClient) Result(ctx context.Context, in *ResultRequest, opts ...grpc.CallOption) (*ResultResponse, error) {
-	out := new(ResultResponse)
-	err := c.cc.Invoke(ctx, Auction_Result_FullMethodName, in, out, opts...)
+func (c *replicationClient) CatchUp(ctx context.Context, in *CatchUpRequest, opts ...grpc.CallOption) (*CatchUpResponse, error) {
+	out := new(CatchUpResponse)
+	err := c.cc.Invoke(ctx, Replication_CatchUp_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// AuctionServer is the server API for Auction service.
-// All implementations must embed UnimplementedAuctionServer
+// ReplicationServer is the server API for Replication service.
+// All implementations must embed UnimplementedReplicationServer
 // for forward compatibility
-type AuctionServer interface {
-	Bid(context.Context, *BidRequest) (*BidResponse, error)
-	Result(context.Context, *ResultRequest) (*ResultResponse, error)
-	mustEmbedUnimplementedAuctionServer()
+type ReplicationServer interface {
+	AppendEntries(context.Context, *AppendEntriesMessage) (*AppendEntriesResponse, error)
+	CatchUp(context.Context, *CatchUpRequest) (*CatchUpResponse, error)
+	mustEmbedUnimplementedReplicationServer()
 }
 
-// UnimplementedAuctionServer must be embedded to have forward compatible implementations.
-type UnimplementedAuctionServer struct {
+// UnimplementedReplicationServer must be embedded to have forward compatible implementations.
+type UnimplementedReplicationServer struct {
 }
 
-func (UnimplementedAuctionServer) Bid(context.Context, *BidRequest) (*BidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bid not implemented")
+func (UnimplementedReplicationServer) AppendEntries(context.Context, *AppendEntriesMessage) (*AppendEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendEntries not implemented")
 }
-func (UnimplementedAuctionServer) Result(context.Context, *ResultRequest) (*ResultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Result not implemented")
+func (UnimplementedReplicationServer) CatchUp(context.Context, *CatchUpRequest) (*CatchUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CatchUp not implemented")
 }
-func (UnimplementedAuctionServer) mustEmbedUnimplementedAuctionServer() {}
+func (UnimplementedReplicationServer) mustEmbedUnimplementedReplicationServer() {}
 
-// UnsafeAuctionServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AuctionServer will
+// UnsafeReplicationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReplicationServer will
 // result in compilation errors.
-type UnsafeAuctionServer interface {
-	mustEmbedUnimplementedAuctionServer()
+type UnsafeReplicationServer interface {
+	mustEmbedUnimplementedReplicationServer()
 }
 
-func RegisterAuctionServer(s grpc.ServiceRegistrar, srv AuctionServer) {
-	s.RegisterService(&Auction_ServiceDesc, srv)
+func RegisterReplicationServer(s grpc.ServiceRegistrar, srv ReplicationServer) {
+	s.RegisterService(&Replication_ServiceDesc, srv)
 }
 
-func _Auction_Bid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BidRequest)
+func _Replication_AppendEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendEntriesMessage)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuctionServer).Bid(ctx, in)
+		return srv.(ReplicationServer).AppendEntries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Auction_Bid_FullMethodName,
+		FullMethod: Replication_AppendEntries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuctionServer).Bid(ctx, req.(*BidRequest))
+		return srv.(ReplicationServer).AppendEntries(ctx, req.(*AppendEntriesMessage))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auction_Result_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResultRequest)
+func _Replication_CatchUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatchUpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuctionServer).Result(ctx, in)
+		return srv.(ReplicationServer).CatchUp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Auction_Result_FullMethodName,
+		FullMethod: Replication_CatchUp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuctionServer).Result(ctx, req.(*ResultRequest))
+		return srv.(ReplicationServer).CatchUp(ctx, req.(*CatchUpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Auction_ServiceDesc is the grpc.ServiceDesc for Auction service.
+// Replication_ServiceDesc is the grpc.ServiceDesc for Replication service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Auction_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "auction.Auction",
-	HandlerType: (*AuctionServer)(nil),
+var Replication_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auction.Replication",
+	HandlerType: (*ReplicationServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Bid",
-			Handler:    _Auction_Bid_Handler,
+			MethodName: "AppendEntries",
+			Handler:    _Replication_AppendEntries_Handler,
 		},
 		{
-			MethodName: "Result",
-			Handler:    _Auction_Result_Handler,
+			MethodName: "CatchUp",
+			Handler:    _Replication_CatchUp_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -146,126 +888,290 @@ var Auction_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Election_Election_FullMethodName    = "/auction.Election/Election"
-	Election_Coordinator_FullMethodName = "/auction.Election/Coordinator"
+	Membership_Members_FullMethodName    = "/auction.Membership/Members"
+	Membership_AddPeer_FullMethodName    = "/auction.Membership/AddPeer"
+	Membership_RemovePeer_FullMethodName = "/auction.Membership/RemovePeer"
 )
 
-// ElectionClient is the client API for Election service.
+// MembershipClient is the client API for Membership service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type ElectionClient interface {
-	Election(ctx context.Context, in *ElectionMessage, opts ...grpc.CallOption) (*Response, error)
-	Coordinator(ctx context.Context, in *CoordinatorMessage, opts ...grpc.CallOption) (*Response, error)
+type MembershipClient interface {
+	Members(ctx context.Context, in *MembersRequest, opts ...grpc.CallOption) (*MembersResponse, error)
+	AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*AddPeerResponse, error)
+	RemovePeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*RemovePeerResponse, error)
 }
 
-type electionClient struct {
+type membershipClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewElectionClient(cc grpc.ClientConnInterface) ElectionClient {
-	return &electionClient{cc}
+func NewMembershipClient(cc grpc.ClientConnInterface) MembershipClient {
+	return &membershipClient{cc}
 }
 
-func (c *electionClient) Election(ctx context.Context, in *ElectionMessage, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, Election_Election_FullMethodName, in, out, opts...)
+func (c *membershipClient) Members(ctx context.Context, in *MembersRequest, opts ...grpc.CallOption) (*MembersResponse, error) {
+	out := new(MembersResponse)
+	err := c.cc.Invoke(ctx, Membership_Members_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *electionClient) Coordinator(ctx context.Context, in *CoordinatorMessage, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, Election_Coordinator_FullMethodName, in, out, opts...)
+func (c *membershipClient) AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*AddPeerResponse, error) {
+	out := new(AddPeerResponse)
+	err := c.cc.Invoke(ctx, Membership_AddPeer_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ElectionServer is the server API for Election service.
-// All implementations must embed UnimplementedElectionServer
+func (c *membershipClient) RemovePeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*RemovePeerResponse, error) {
+	out := new(RemovePeerResponse)
+	err := c.cc.Invoke(ctx, Membership_RemovePeer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MembershipServer is the server API for Membership service.
+// All implementations must embed UnimplementedMembershipServer
 // for forward compatibility
-type ElectionServer interface {
-	Election(context.Context, *ElectionMessage) (*Response, error)
-	Coordinator(context.Context, *CoordinatorMessage) (*Response, error)
-	mustEmbedUnimplementedElectionServer()
+type MembershipServer interface {
+	Members(context.Context, *MembersRequest) (*MembersResponse, error)
+	AddPeer(context.Context, *AddPeerRequest) (*AddPeerResponse, error)
+	RemovePeer(context.Context, *RemovePeerRequest) (*RemovePeerResponse, error)
+	mustEmbedUnimplementedMembershipServer()
 }
 
-// UnimplementedElectionServer must be embedded to have forward compatible implementations.
-type UnimplementedElectionServer struct {
+// UnimplementedMembershipServer must be embedded to have forward compatible implementations.
+type UnimplementedMembershipServer struct {
 }
 
-func (UnimplementedElectionServer) Election(context.Context, *ElectionMessage) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Election not implemented")
+func (UnimplementedMembershipServer) Members(context.Context, *MembersRequest) (*MembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Members not implemented")
 }
-func (UnimplementedElectionServer) Coordinator(context.Context, *CoordinatorMessage) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Coordinator not implemented")
+func (UnimplementedMembershipServer) AddPeer(context.Context, *AddPeerRequest) (*AddPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeer not implemented")
 }
-func (UnimplementedElectionServer) mustEmbedUnimplementedElectionServer() {}
+func (UnimplementedMembershipServer) RemovePeer(context.Context, *RemovePeerRequest) (*RemovePeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePeer not implemented")
+}
+func (UnimplementedMembershipServer) mustEmbedUnimplementedMembershipServer() {}
 
-// UnsafeElectionServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ElectionServer will
+// UnsafeMembershipServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MembershipServer will
 // result in compilation errors.
-type UnsafeElectionServer interface {
-	mustEmbedUnimplementedElectionServer()
+type UnsafeMembershipServer interface {
+	mustEmbedUnimplementedMembershipServer()
 }
 
-func RegisterElectionServer(s grpc.ServiceRegistrar, srv ElectionServer) {
-	s.RegisterService(&Election_ServiceDesc, srv)
+func RegisterMembershipServer(s grpc.ServiceRegistrar, srv MembershipServer) {
+	s.RegisterService(&Membership_ServiceDesc, srv)
 }
 
-func _Election_Election_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ElectionMessage)
+func _Membership_Members_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ElectionServer).Election(ctx, in)
+		return srv.(MembershipServer).Members(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Election_Election_FullMethodName,
+		FullMethod: Membership_Members_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ElectionServer).Election(ctx, req.(*ElectionMessage))
+		return srv.(MembershipServer).Members(ctx, req.(*MembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Election_Coordinator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CoordinatorMessage)
+func _Membership_AddPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ElectionServer).Coordinator(ctx, in)
+		return srv.(MembershipServer).AddPeer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Election_Coordinator_FullMethodName,
+		FullMethod: Membership_AddPeer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ElectionServer).Coordinator(ctx, req.(*CoordinatorMessage))
+		return srv.(MembershipServer).AddPeer(ctx, req.(*AddPeerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Election_ServiceDesc is the grpc.ServiceDesc for Election service.
+func _Membership_RemovePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MembershipServer).RemovePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Membership_RemovePeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MembershipServer).RemovePeer(ctx, req.(*RemovePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Membership_ServiceDesc is the grpc.ServiceDesc for Membership service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var Election_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "auction.Election",
-	HandlerType: (*ElectionServer)(nil),
+var Membership_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auction.Membership",
+	HandlerType: (*MembershipServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Election",
-			Handler:    _Election_Election_Handler,
+			MethodName: "Members",
+			Handler:    _Membership_Members_Handler,
+		},
+		{
+			MethodName: "AddPeer",
+			Handler:    _Membership_AddPeer_Handler,
+		},
+		{
+			MethodName: "RemovePeer",
+			Handler:    _Membership_RemovePeer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auction.proto",
+}
+
+const (
+	FailureDetector_Heartbeat_FullMethodName = "/auction.FailureDetector/Heartbeat"
+	FailureDetector_View_FullMethodName      = "/auction.FailureDetector/View"
+)
+
+// FailureDetectorClient is the client API for FailureDetector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FailureDetectorClient interface {
+	Heartbeat(ctx context.Context, in *HeartbeatMessage, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	View(ctx context.Context, in *ViewRequest, opts ...grpc.CallOption) (*ViewResponse, error)
+}
+
+type failureDetectorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFailureDetectorClient(cc grpc.ClientConnInterface) FailureDetectorClient {
+	return &failureDetectorClient{cc}
+}
+
+func (c *failureDetectorClient) Heartbeat(ctx context.Context, in *HeartbeatMessage, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, FailureDetector_Heartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *failureDetectorClient) View(ctx context.Context, in *ViewRequest, opts ...grpc.CallOption) (*ViewResponse, error) {
+	out := new(ViewResponse)
+	err := c.cc.Invoke(ctx, FailureDetector_View_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FailureDetectorServer is the server API for FailureDetector service.
+// All implementations must embed UnimplementedFailureDetectorServer
+// for forward compatibility
+type FailureDetectorServer interface {
+	Heartbeat(context.Context, *HeartbeatMessage) (*HeartbeatResponse, error)
+	View(context.Context, *ViewRequest) (*ViewResponse, error)
+	mustEmbedUnimplementedFailureDetectorServer()
+}
+
+// UnimplementedFailureDetectorServer must be embedded to have forward compatible implementations.
+type UnimplementedFailureDetectorServer struct {
+}
+
+func (UnimplementedFailureDetectorServer) Heartbeat(context.Context, *HeartbeatMessage) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedFailureDetectorServer) View(context.Context, *ViewRequest) (*ViewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method View not implemented")
+}
+func (UnimplementedFailureDetectorServer) mustEmbedUnimplementedFailureDetectorServer() {}
+
+// UnsafeFailureDetectorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FailureDetectorServer will
+// result in compilation errors.
+type UnsafeFailureDetectorServer interface {
+	mustEmbedUnimplementedFailureDetectorServer()
+}
+
+func RegisterFailureDetectorServer(s grpc.ServiceRegistrar, srv FailureDetectorServer) {
+	s.RegisterService(&FailureDetector_ServiceDesc, srv)
+}
+
+func _FailureDetector_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FailureDetectorServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FailureDetector_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FailureDetectorServer).Heartbeat(ctx, req.(*HeartbeatMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FailureDetector_View_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FailureDetectorServer).View(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FailureDetector_View_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FailureDetectorServer).View(ctx, req.(*ViewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FailureDetector_ServiceDesc is the grpc.ServiceDesc for FailureDetector service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FailureDetector_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auction.FailureDetector",
+	HandlerType: (*FailureDetectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Heartbeat",
+			Handler:    _FailureDetector_Heartbeat_Handler,
 		},
 		{
-			MethodName: "Coordinator",
-			Handler:    _Election_Coordinator_Handler,
+			MethodName: "View",
+			Handler:    _FailureDetector_View_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
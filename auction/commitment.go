@@ -0,0 +1,21 @@
+package auction
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// Commitment returns the commitment to a sealed bid. It binds the bid to the auction and the bidder, so a commitment
+// can not be copied by another bidder, and the nonce keeps the amount from being guessed by hashing every amount.
+func Commitment(auctionId int32, bidderId int32, amount int64, nonce []byte) []byte {
+	hash := sha256.New()
+
+	var number [8]byte
+	for _, value := range []int64{int64(auctionId), int64(bidderId), amount} {
+		binary.BigEndian.PutUint64(number[:], uint64(value))
+		hash.Write(number[:])
+	}
+	hash.Write(nonce)
+
+	return hash.Sum(nil)
+}
@@ -0,0 +1,38 @@
+package auction
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCommitment(t *testing.T) {
+	nonce := bytes.Repeat([]byte{7}, 32)
+	commitment := Commitment(1, 2, 100, nonce)
+
+	if len(commitment) != 32 {
+		t.Fatalf("the commitment has %d bytes, want 32", len(commitment))
+	}
+
+	if !bytes.Equal(commitment, Commitment(1, 2, 100, bytes.Clone(nonce))) {
+		t.Errorf("equal bids have different commitments")
+	}
+
+	tests := []struct {
+		name       string
+		commitment []byte
+	}{
+		{"auction", Commitment(3, 2, 100, nonce)},
+		{"bidder", Commitment(1, 3, 100, nonce)},
+		{"amount", Commitment(1, 2, 101, nonce)},
+		{"nonce", Commitment(1, 2, 100, bytes.Repeat([]byte{8}, 32))},
+		{"no nonce", Commitment(1, 2, 100, nil)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if bytes.Equal(commitment, test.commitment) {
+				t.Errorf("changing the %s does not change the commitment", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package auction
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// Chain returns the hash of the ledger record chained to the hash of the entry before it. The first entry is chained
+// to an empty hash. Changing any entry changes the hash of that entry and of every entry after it.
+func Chain(previous []byte, record *LedgerRecord) []byte {
+	hash := sha256.New()
+	hash.Write(previous)
+
+	// The fields are written with fixed sizes or their lengths, so two different records never write the same bytes.
+	var finished int64
+	if record.Finished {
+		finished = 1
+	}
+
+	var number [8]byte
+	for _, value := range []int64{record.Timestamp, int64(record.BidderId), record.HighestBid, record.Price, finished, int64(len(record.Name))} {
+		binary.BigEndian.PutUint64(number[:], uint64(value))
+		hash.Write(number[:])
+	}
+	hash.Write([]byte(record.Name))
+
+	return hash.Sum(nil)
+}
@@ -0,0 +1,43 @@
+package auction
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	record := &LedgerRecord{Timestamp: 1700000000000, BidderId: 2, Name: "alice", HighestBid: 61, Price: 0}
+	previous := []byte("previous")
+	hash := Chain(previous, record)
+
+	if len(hash) != 32 {
+		t.Fatalf("the hash has %d bytes, want 32", len(hash))
+	}
+
+	if !bytes.Equal(hash, Chain(previous, &LedgerRecord{Timestamp: 1700000000000, BidderId: 2, Name: "alice", HighestBid: 61, Price: 0})) {
+		t.Errorf("equal records have different hashes")
+	}
+
+	tests := []struct {
+		name     string
+		previous []byte
+		record   *LedgerRecord
+	}{
+		{"first entry", nil, record},
+		{"previous", []byte("other"), record},
+		{"timestamp", previous, &LedgerRecord{Timestamp: 1700000000001, BidderId: 2, Name: "alice", HighestBid: 61}},
+		{"bidder", previous, &LedgerRecord{Timestamp: 1700000000000, BidderId: 3, Name: "alice", HighestBid: 61}},
+		{"name", previous, &LedgerRecord{Timestamp: 1700000000000, BidderId: 2, Name: "alicf", HighestBid: 61}},
+		{"highest bid", previous, &LedgerRecord{Timestamp: 1700000000000, BidderId: 2, Name: "alice", HighestBid: 55}},
+		{"price", previous, &LedgerRecord{Timestamp: 1700000000000, BidderId: 2, Name: "alice", HighestBid: 61, Price: 61}},
+		{"finished", previous, &LedgerRecord{Timestamp: 1700000000000, BidderId: 2, Name: "alice", HighestBid: 61, Finished: true}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if bytes.Equal(hash, Chain(test.previous, test.record)) {
+				t.Errorf("changing the %s does not change the hash", test.name)
+			}
+		})
+	}
+}
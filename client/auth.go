@@ -0,0 +1,45 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// login registers or logs the user in, and returns a context that sends the token with every call made with it.
+// The servers know the user and the role of the user by the token, so they can not be made up.
+func (c *client) login(ctx context.Context) (context.Context, error) {
+	var errors []error
+	for _, client := range c.Accounts {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		response, error := c.authenticate(call, client)
+		cancel()
+		if error == nil {
+			c.Id = int(response.Id)
+			c.Role = response.Role
+			log.Printf("Logged in as %s (%s %d)", c.Name, strings.ToLower(c.Role.String()), c.Id)
+			return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+response.Token), nil
+		}
+
+		errors = append(errors, error)
+	}
+
+	for _, error := range errors {
+		log.Print(error)
+	}
+
+	return ctx, fmt.Errorf("no server logged %s in", c.Name)
+}
+
+// authenticate registers the user if -register is set, or logs it in otherwise. Only the leader registers users.
+func (c *client) authenticate(ctx context.Context, client auction.AuthenticationClient) (*auction.TokenResponse, error) {
+	if c.Register {
+		return client.Register(ctx, &auction.RegisterRequest{Name: c.Name, Password: c.Password, Role: c.Role, Code: c.Code})
+	}
+
+	return client.Login(ctx, &auction.LoginRequest{Name: c.Name, Password: c.Password})
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+
+	"google.golang.org/grpc/status"
+)
+
+// reply is the answer of one server to a bid.
+type reply struct {
+	Server   string
+	Response *auction.BidResponse
+	Error    error
+}
+
+// outcome returns the response to the bid. A failed bid carries its response in the details of the error,
+// and an error without one means the server could not decide the bid.
+func outcome(response *auction.BidResponse, error error) *auction.BidResponse {
+	if error == nil {
+		return response
+	}
+
+	for _, detail := range status.Convert(error).Details() {
+		response, ok := detail.(*auction.BidResponse)
+		if ok {
+			return response
+		}
+	}
+
+	return &auction.BidResponse{Outcome: auction.Outcome_EXCEPTION}
+}
+
+// bid sends the bid to every server. The leader decides it, and the followers answer once they have applied it too,
+// so the bid only counts once a quorum of the servers agree on its outcome. It stops waiting as soon as they do.
+func (c *client) bid(ctx context.Context, bidAmount int, maximum int) {
+	// Every server gets the same request id, so they know it is the same bid, and a retry is not placed twice.
+	request := &auction.BidRequest{
+		Amount:    int64(bidAmount),
+		AuctionId: c.Auction,
+		Maximum:   int64(maximum),
+		RequestId: requestId(),
+	}
+
+	replies := make([]reply, len(c.Clients))
+	var answers []reply
+	answered := c.fanOut(ctx, func(ctx context.Context, i int) {
+		response, error := c.Clients[i].Bid(ctx, request)
+		replies[i] = reply{Server: c.Servers[i], Response: outcome(response, error), Error: error}
+	}, func(i int) bool {
+		answers = append(answers, replies[i])
+		return c.decide(answers) != nil
+	})
+
+	if len(answered) < len(c.Clients) && c.decide(answers) == nil {
+		log.Printf("Only %d of %d servers answered in time", len(answered), len(c.Clients))
+	}
+
+	decided := c.decide(answers)
+	switch {
+	case decided == nil:
+		log.Printf("EXCEPTION: fewer than %d servers agreed on the outcome of the bid", c.quorum())
+	case decided.Response.Outcome == auction.Outcome_SUCCESS && decided.Response.HighestBid > 0:
+		log.Printf("SUCCESS: the bid was placed - highest bid: %d", decided.Response.HighestBid)
+	case decided.Response.Outcome == auction.Outcome_SUCCESS:
+		log.Printf("SUCCESS: the bid was placed")
+	default:
+		log.Printf("FAIL: %s", status.Convert(decided.Error).Message())
+	}
+
+	for _, reply := range answers {
+		if decided != nil && reply.agrees(*decided) {
+			continue
+		}
+
+		if reply.Error == nil {
+			log.Printf("%s disagreed: %s", reply.Server, reply.Response.Outcome)
+		} else if reply.Response.Outcome == auction.Outcome_FAIL {
+			log.Printf("%s disagreed: %s %s - %s", reply.Server, reply.Response.Outcome, reply.Response.Reason, status.Convert(reply.Error).Message())
+		} else {
+			log.Printf("%s disagreed: %s - %s", reply.Server, reply.Response.Outcome, status.Convert(reply.Error).Message())
+		}
+	}
+}
+
+func requestId() string {
+	id := make([]byte, 16)
+	rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
+
+// agrees reports whether the replies have the same outcome. Failures only agree if they fail for the same reason.
+func (r reply) agrees(other reply) bool {
+	return r.Response.Outcome == other.Response.Outcome && r.Response.Reason == other.Response.Reason
+}
+
+// decide returns a reply that a quorum of the servers agree with, or nil if there is none.
+func (c *client) decide(replies []reply) *reply {
+	for i, candidate := range replies {
+		if candidate.Response.Outcome == auction.Outcome_EXCEPTION {
+			continue
+		}
+
+		agreeing := 0
+		for _, reply := range replies {
+			if reply.agrees(candidate) {
+				agreeing++
+			}
+		}
+
+		if agreeing >= c.quorum() {
+			return &replies[i]
+		}
+	}
+
+	return nil
+}
+
+// quorum returns how many servers have to agree, which is a majority unless -quorum is set.
+func (c *client) quorum() int {
+	if c.Quorum > 0 {
+		return c.Quorum
+	}
+
+	return len(c.Clients)/2 + 1
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"auction/auction"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func rejected(reason auction.Reason) reply {
+	return reply{Response: &auction.BidResponse{Outcome: auction.Outcome_FAIL, Reason: reason}, Error: errors.New(reason.String())}
+}
+
+func TestDecide(t *testing.T) {
+	placed := reply{Response: &auction.BidResponse{Outcome: auction.Outcome_SUCCESS}}
+	down := reply{Response: &auction.BidResponse{Outcome: auction.Outcome_EXCEPTION}, Error: status.Error(codes.Unavailable, "connection refused")}
+	tooLow := rejected(auction.Reason_TOO_LOW)
+	done := rejected(auction.Reason_AUCTION_DONE)
+
+	tests := []struct {
+		name    string
+		quorum  int
+		replies []reply
+		outcome auction.Outcome
+		reason  auction.Reason
+	}{
+		{"every server placed it", 0, []reply{placed, placed, placed}, auction.Outcome_SUCCESS, auction.Reason_NONE},
+		{"a majority placed it", 0, []reply{placed, down, placed}, auction.Outcome_SUCCESS, auction.Reason_NONE},
+		{"a majority rejected it for the same reason", 0, []reply{tooLow, placed, tooLow}, auction.Outcome_FAIL, auction.Reason_TOO_LOW},
+		{"rejected for different reasons", 0, []reply{tooLow, done, placed}, auction.Outcome_EXCEPTION, auction.Reason_NONE},
+		{"a majority could not decide it", 0, []reply{down, down, placed}, auction.Outcome_EXCEPTION, auction.Reason_NONE},
+		{"every server has to agree", 3, []reply{placed, placed, down}, auction.Outcome_EXCEPTION, auction.Reason_NONE},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := Client("test", "password", false, auction.Role_BIDDER, "", test.quorum, time.Second, time.Second, insecure.NewCredentials(), nil)
+			c.Clients = make([]auction.AuctionClient, len(test.replies))
+
+			outcome, reason := auction.Outcome_EXCEPTION, auction.Reason_NONE
+			if decided := c.decide(test.replies); decided != nil {
+				outcome, reason = decided.Response.Outcome, decided.Response.Reason
+			}
+
+			if outcome != test.outcome || reason != test.reason {
+				t.Errorf("decided %s %s, want %s %s", outcome, reason, test.outcome, test.reason)
+			}
+		})
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	detailed, _ := status.New(codes.FailedPrecondition, "your bid is too low").WithDetails(&auction.BidResponse{Outcome: auction.Outcome_FAIL, Reason: auction.Reason_TOO_LOW})
+
+	tests := []struct {
+		name     string
+		response *auction.BidResponse
+		error    error
+		outcome  auction.Outcome
+		reason   auction.Reason
+	}{
+		{"placed", &auction.BidResponse{Outcome: auction.Outcome_SUCCESS}, nil, auction.Outcome_SUCCESS, auction.Reason_NONE},
+		{"rejected", nil, detailed.Err(), auction.Outcome_FAIL, auction.Reason_TOO_LOW},
+		{"unreachable", nil, status.Error(codes.Unavailable, "connection refused"), auction.Outcome_EXCEPTION, auction.Reason_NONE},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := outcome(test.response, test.error)
+			if response.Outcome != test.outcome || response.Reason != test.reason {
+				t.Errorf("outcome = %s %s, want %s %s", response.Outcome, response.Reason, test.outcome, test.reason)
+			}
+		})
+	}
+}
@@ -4,49 +4,110 @@ import (
 	"auction/auction"
 	"bufio"
 	"context"
+	"encoding/hex"
 	"flag"
+	"io"
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
-var id = flag.Int("id", 1, "The id of the client")
-var name = flag.String("name", "John Doe", "The name of the client")
+const (
+	watchRetry      = time.Second
+	discoverTimeout = time.Second
+)
+
+var name = flag.String("name", "John Doe", "The name of the bidder")
+var password = flag.String("password", "", "The password of the bidder")
+var register = flag.Bool("register", false, "Register the user before logging in")
+var role = flag.String("role", "bidder", "The role the user registers with: bidder, auctioneer or observer")
+var code = flag.String("code", "", "The code of the servers, which is needed to register as an auctioneer")
+var timeout = flag.Duration("timeout", 3*time.Second, "How long to wait for a server to answer")
+var deadline = flag.Duration("deadline", 5*time.Second, "How long to wait for the servers to answer a bid or a result")
+var quorum = flag.Int("quorum", 0, "How many servers have to agree on the outcome of a bid. Defaults to a majority")
+var servers = flag.String("servers", "localhost:5000,localhost:5001,localhost:5002", "The addresses of servers to ask for the members of the cluster, separated by commas")
 
 type client struct {
-	Id   int
-	Name string
+	// Id is given by the servers when the user logs in, and Role once the user has logged in.
+	Id       int
+	Name     string
+	Password string
+	Register bool
+	Role     auction.Role
+	Code     string
+
+	Auction      int32
+	StopWatching context.CancelFunc
 
-	Clients []auction.AuctionClient
+	// Commitments are the sealed bids the client has committed to by auction, until they are revealed.
+	Commitments map[int32]sealedBid
+
+	Quorum   int
+	Timeout  time.Duration
+	Deadline time.Duration
+
+	Credentials credentials.TransportCredentials
+	Servers     []string
+	Connections []*grpc.ClientConn
+	Clients     []auction.AuctionClient
+	Accounts    []auction.AuthenticationClient
+	Memberships []auction.MembershipClient
+	Detectors   []auction.FailureDetectorClient
 }
 
-func Client(id int, name string) *client {
+func Client(name string, password string, register bool, role auction.Role, code string, quorum int, timeout time.Duration, deadline time.Duration, credentials credentials.TransportCredentials, servers []string) *client {
 	return &client{
-		Id:   id,
-		Name: name,
+		Name:     name,
+		Password: password,
+		Register: register,
+		Role:     role,
+		Code:     code,
+		Quorum:   quorum,
+		Timeout:  timeout,
+		Deadline: deadline,
+
+		Commitments: make(map[int32]sealedBid),
+
+		Credentials: credentials,
+		Servers:     servers,
 	}
 }
 
 func main() {
 	flag.Parse()
 
-	c := Client(*id, *name)
+	credentials, error := loadCredentials()
+	if error != nil {
+		log.Fatalf("Invalid certificate authority: %s", error)
+	}
+
+	userRole, ok := auction.Role_value[strings.ToUpper(*role)]
+	if !ok {
+		log.Fatalf("Invalid role: %s", *role)
+	}
+
+	c := Client(*name, *password, *register, auction.Role(userRole), *code, *quorum, *timeout, *deadline, credentials, strings.Split(*servers, ","))
 	c.client()
 }
 
 func (c *client) client() {
 	ctx := context.Background()
 
-	for i := 5000; i <= 5002; i++ {
-		connection, error := grpc.Dial(":"+strconv.Itoa(i), grpc.WithTransportCredentials(insecure.NewCredentials()))
-		if error != nil {
-			log.Fatalf("Connecting to server failed: %s", error)
-		}
+	// If no server is up yet, the client uses the servers it was given.
+	if !c.discover(ctx) {
+		c.connect(c.Servers)
+	}
 
-		c.Clients = append(c.Clients, auction.NewAuctionClient(connection))
+	ctx, error := c.login(ctx)
+	if error != nil {
+		log.Fatalf("Logging in failed: %s", error)
 	}
 
 	c.run(ctx)
@@ -55,6 +116,8 @@ func (c *client) client() {
 func (c *client) run(ctx context.Context) {
 	scanner := bufio.NewScanner(os.Stdin)
 
+	c.startWatching(ctx)
+
 	for {
 		if scanner.Scan() {
 			text := scanner.Text()
@@ -64,82 +127,445 @@ func (c *client) run(ctx context.Context) {
 				continue
 			}
 
-			bidAmount, error := strconv.Atoi(text)
+			if text == "/auctions" {
+				c.list(ctx)
+				continue
+			}
+
+			if text == "/rules" {
+				c.rules(ctx)
+				continue
+			}
+
+			if text == "/accept" {
+				c.accept(ctx)
+				continue
+			}
+
+			if text == "/members" {
+				c.members(ctx)
+				continue
+			}
+
+			if text == "/health" {
+				c.health(ctx)
+				continue
+			}
+
+			if text == "/verify" {
+				c.verify(ctx)
+				continue
+			}
+
+			command, argument, _ := strings.Cut(text, " ")
+			switch command {
+			case "/auction":
+				auctionId, error := strconv.Atoi(argument)
+				if error != nil {
+					log.Print("not a valid auction")
+					continue
+				}
+
+				c.Auction = int32(auctionId)
+				log.Printf("Bidding in auction %d", c.Auction)
+				c.startWatching(ctx)
+				continue
+			case "/create":
+				c.create(ctx, argument)
+				continue
+			case "/start":
+				auctionId, error := strconv.Atoi(argument)
+				if error != nil {
+					log.Print("not a valid auction")
+					continue
+				}
+
+				c.start(ctx, int32(auctionId))
+				continue
+			case "/close":
+				auctionId, error := strconv.Atoi(argument)
+				if error != nil {
+					log.Print("not a valid auction")
+					continue
+				}
+
+				c.close(ctx, int32(auctionId))
+				continue
+			case "/history":
+				page := 1
+				if argument != "" {
+					parsed, error := strconv.Atoi(argument)
+					if error != nil || parsed < 1 {
+						log.Print("not a valid page")
+						continue
+					}
+
+					page = parsed
+				}
+
+				c.history(ctx, page)
+				continue
+			case "/reveal":
+				if argument == "" {
+					bid, ok := c.Commitments[c.Auction]
+					if !ok {
+						log.Printf("You have not committed to a bid in auction %d. Write /reveal <amount> <nonce> to reveal a bid from before a restart.", c.Auction)
+						continue
+					}
+
+					c.reveal(ctx, bid)
+					continue
+				}
+
+				amount, nonce, _ := strings.Cut(argument, " ")
+				bidAmount, error := strconv.Atoi(amount)
+				if error != nil {
+					log.Print("not a valid bid")
+					continue
+				}
+
+				decoded, error := hex.DecodeString(nonce)
+				if error != nil || len(decoded) == 0 {
+					log.Print("not a valid nonce")
+					continue
+				}
+
+				c.reveal(ctx, sealedBid{Amount: int64(bidAmount), Nonce: decoded})
+				continue
+			case "/add":
+				c.addPeer(ctx, argument)
+				continue
+			case "/remove":
+				c.removePeer(ctx, argument)
+				continue
+			}
+
+			bidAmount, error := strconv.Atoi(command)
 			if error != nil {
 				log.Print("not a valid bid")
 				continue
 			}
 
-			c.bid(ctx, bidAmount)
+			maximum := 0
+			if argument != "" {
+				maximum, error = strconv.Atoi(argument)
+				if error != nil {
+					log.Print("not a valid maximum bid")
+					continue
+				}
+			}
+
+			// A sealed auction only takes a commitment to the bid until the bidding is over.
+			rules := c.fetchRules(ctx)
+			if rules.GetType() == auction.AuctionType_SEALED || rules.GetType() == auction.AuctionType_VICKREY {
+				if maximum != 0 {
+					log.Print("maximum bids can not be used in a sealed auction")
+					continue
+				}
+
+				c.commit(ctx, int64(bidAmount))
+				continue
+			}
+
+			c.bid(ctx, bidAmount, maximum)
 		}
 	}
 }
 
+// result asks every server for the result, and prints it as soon as a quorum of them give the same one.
+// Otherwise the result most servers gave is printed, unless as many gave another one.
 func (c *client) result(ctx context.Context) {
-	var responses map[string]int = make(map[string]int)
+	responses := make([]*auction.ResultResponse, len(c.Clients))
+	counts := make(map[string]int)
+	results := make(map[string]*auction.ResultResponse)
+
+	c.fanOut(ctx, func(ctx context.Context, i int) {
+		response, error := c.Clients[i].Result(ctx, &auction.ResultRequest{AuctionId: c.Auction})
+		if error == nil {
+			responses[i] = response
+		}
+	}, func(i int) bool {
+		if responses[i] == nil {
+			return false
+		}
+
+		counts[responses[i].String()]++
+		results[responses[i].String()] = responses[i]
+		return counts[responses[i].String()] >= c.quorum()
+	})
+
 	var mostOccuringResponse *auction.ResultResponse
 	var mostOcurrences int
 	var secondMostOcurrences int
 
-	for _, client := range c.Clients {
-		response, error := client.Result(ctx, &auction.ResultRequest{})
-		if error != nil {
-			continue
-		}
-
-		i, ok := responses[response.String()]
-		if ok {
-			responses[response.String()] = i + 1
-			if i+1 > mostOcurrences {
-				mostOccuringResponse = response
-				mostOcurrences = i + 1
-			} else if i+1 > secondMostOcurrences {
-				secondMostOcurrences = i + 1
-			}
-		} else {
-			responses[response.String()] = 1
-			if 1 > mostOcurrences {
-				mostOccuringResponse = response
-				mostOcurrences = 1
-			} else if 1 > secondMostOcurrences {
-				secondMostOcurrences = 1
-			}
+	for response, ocurrences := range counts {
+		if ocurrences > mostOcurrences {
+			secondMostOcurrences = mostOcurrences
+			mostOccuringResponse = results[response]
+			mostOcurrences = ocurrences
+		} else if ocurrences > secondMostOcurrences {
+			secondMostOcurrences = ocurrences
 		}
 	}
 
 	if mostOccuringResponse == nil {
 		log.Printf("No response from the server")
 	} else if mostOcurrences > secondMostOcurrences {
-		switch event := mostOccuringResponse.Event.(type) {
-		case *auction.ResultResponse_Status:
+		c.print(mostOccuringResponse)
+	} else {
+		log.Printf("Mismatched response from the server")
+	}
+}
+
+func (c *client) print(response *auction.ResultResponse) {
+	switch event := response.Event.(type) {
+	case *auction.ResultResponse_Status:
+		sealed := response.Rules.GetType() == auction.AuctionType_SEALED || response.Rules.GetType() == auction.AuctionType_VICKREY
+		if !event.Status.Started && response.Rules.GetStart() != auction.StartMode_FIRST_BID {
+			log.Printf("The auction has not started yet.")
+		} else if response.Rules.GetType() == auction.AuctionType_DUTCH {
+			log.Printf("The price is %d. There are %d seconds left of the auction.", event.Status.Ask, event.Status.Time)
+		} else if sealed && event.Status.Revealing {
+			log.Printf("The bidding is over. Write /reveal to reveal your bid. There are %d seconds left to reveal the bids.", event.Status.Time)
+		} else if sealed {
+			log.Printf("The bids are sealed. There are %d seconds left of the auction.", event.Status.Time)
+		} else if event.Status.Extended > 0 {
+			log.Printf("The highest bid is %d. There are %d seconds left of the auction, which was extended by %d seconds.", event.Status.HighestBid, event.Status.Time, event.Status.Extended)
+		} else {
 			log.Printf("The highest bid is %d. There are %d seconds left of the auction.", event.Status.HighestBid, event.Status.Time)
-		case *auction.ResultResponse_Winner:
+		}
+	case *auction.ResultResponse_Winner:
+		if event.Winner.Name == "" {
+			log.Printf("The auction is over without a winner.")
+		} else if event.Winner.Price != event.Winner.Amount {
+			log.Printf("The auction is over. The winning bid is %d by %s, who pays %d", event.Winner.Amount, event.Winner.Name, event.Winner.Price)
+		} else {
 			log.Printf("The auction is over. The winning bid is %d by %s", event.Winner.Amount, event.Winner.Name)
 		}
-	} else {
-		log.Printf("Mismatched response from the server")
+
+		if len(response.LedgerHead) > 0 {
+			log.Printf("The head of the ledger is %x. Write /verify to check the ledgers of the servers.", response.LedgerHead)
+		}
 	}
 }
 
-func (c *client) bid(ctx context.Context, bidAmount int) {
+func (c *client) create(ctx context.Context, name string) {
 	var errors []error
 	for _, client := range c.Clients {
-		_, error := client.Bid(ctx, &auction.BidRequest{
-			Id:     int32(c.Id),
-			Name:   c.Name,
-			Amount: int64(bidAmount),
-		})
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		response, error := client.CreateAuction(call, &auction.CreateAuctionRequest{Name: name})
+		cancel()
+		if error == nil {
+			log.Printf("Created auction %d: %s", response.AuctionId, name)
+			return
+		}
 
+		errors = append(errors, error)
+	}
+
+	for _, error := range errors {
+		log.Print(error)
+	}
+}
+
+func (c *client) start(ctx context.Context, auctionId int32) {
+	var errors []error
+	for _, client := range c.Clients {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		_, error := client.StartAuction(call, &auction.StartAuctionRequest{AuctionId: auctionId})
+		cancel()
+		if error == nil {
+			log.Printf("Started auction %d", auctionId)
+			return
+		}
+
+		errors = append(errors, error)
+	}
+
+	for _, error := range errors {
+		log.Print(error)
+	}
+}
+
+func (c *client) close(ctx context.Context, auctionId int32) {
+	var errors []error
+	for _, client := range c.Clients {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		_, error := client.CloseAuction(call, &auction.CloseAuctionRequest{AuctionId: auctionId})
+		cancel()
+		if error == nil {
+			log.Printf("Closed auction %d", auctionId)
+			return
+		}
+
+		errors = append(errors, error)
+	}
+
+	for _, error := range errors {
+		log.Print(error)
+	}
+}
+
+func (c *client) accept(ctx context.Context) {
+	var errors []error
+
+	// Every server gets the same request id, so a retry gets the outcome of the first acceptance.
+	request := &auction.AcceptRequest{AuctionId: c.Auction, RequestId: requestId()}
+	for _, client := range c.Clients {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		response, error := client.Accept(call, request)
+		cancel()
+		if error == nil {
+			log.Printf("You won the auction for %d", response.Price)
+			return
+		}
+
+		errors = append(errors, error)
+	}
+
+	for _, error := range errors {
+		log.Print(error)
+	}
+}
+
+// fetchRules returns the rules of the current auction, or nil if no server answered.
+func (c *client) fetchRules(ctx context.Context) *auction.Rules {
+	for _, client := range c.Clients {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		response, error := client.Result(call, &auction.ResultRequest{AuctionId: c.Auction})
+		cancel()
+		if error == nil {
+			return response.Rules
+		}
+	}
+
+	return nil
+}
+
+func (c *client) rules(ctx context.Context) {
+	rules := c.fetchRules(ctx)
+	if rules != nil {
+		switch rules.Type {
+		case auction.AuctionType_ENGLISH:
+			log.Printf("This is an open auction. The highest bid wins.")
+		case auction.AuctionType_SEALED:
+			log.Printf("This is a sealed auction. Everyone bids once without seeing the other bids, and the highest bid wins.")
+			log.Printf("Only a commitment to the bid is sent during the bidding. The bid is revealed with /reveal in the %d seconds after it.", rules.RevealWindow)
+		case auction.AuctionType_VICKREY:
+			log.Printf("This is a sealed auction. Everyone bids once without seeing the other bids, and the highest bid wins but pays the second highest bid.")
+			log.Printf("Only a commitment to the bid is sent during the bidding. The bid is revealed with /reveal in the %d seconds after it.", rules.RevealWindow)
+		case auction.AuctionType_DUTCH:
+			log.Printf("This is a dutch auction. The price drops by %d every %d seconds until someone accepts it, but never below %d.", rules.Increment, rules.DropInterval, rules.Reserve)
+		}
+
+		switch rules.Start {
+		case auction.StartMode_FIRST_BID:
+			log.Printf("The auction starts with the first bid.")
+		case auction.StartMode_AT_TIME:
+			log.Printf("The auction starts at %s.", time.Unix(rules.StartAt, 0).Format(time.RFC3339))
+		case auction.StartMode_MANUAL:
+			log.Printf("The auction is started by the auctioneer.")
+		}
+
+		if rules.Type == auction.AuctionType_ENGLISH {
+			log.Printf("It runs for %d seconds. Bids start at %d and have to raise the highest bid by at least %d.", rules.Duration, rules.StartingBid, rules.Increment)
+		} else if rules.Type == auction.AuctionType_DUTCH {
+			log.Printf("It runs for %d seconds. The price starts at %d.", rules.Duration, rules.StartingBid)
+		} else {
+			log.Printf("It runs for %d seconds. Bids start at %d.", rules.Duration, rules.StartingBid)
+		}
+		if rules.Reserve > 0 && rules.Type != auction.AuctionType_DUTCH {
+			log.Printf("The winning bid has to be at least %d.", rules.Reserve)
+		}
+
+		if rules.Extension > 0 {
+			log.Printf("Bids in the last %d seconds extend the auction by %d seconds.", rules.ExtensionWindow, rules.Extension)
+		}
+		return
+	}
+
+	log.Printf("No response from the server")
+}
+
+func (c *client) list(ctx context.Context) {
+	for _, client := range c.Clients {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		response, error := client.ListAuctions(call, &auction.ListAuctionsRequest{})
+		cancel()
 		if error != nil {
-			errors = append(errors, error)
+			continue
 		}
+
+		for _, lot := range response.Auctions {
+			if lot.Finished {
+				log.Printf("Auction %d (%s) is over. The highest bid was %d.", lot.AuctionId, lot.Name, lot.HighestBid)
+			} else {
+				log.Printf("Auction %d (%s): the highest bid is %d. There are %d seconds left.", lot.AuctionId, lot.Name, lot.HighestBid, lot.Time)
+			}
+		}
+		return
+	}
+
+	log.Printf("No response from the server")
+}
+
+func (c *client) startWatching(ctx context.Context) {
+	if c.StopWatching != nil {
+		c.StopWatching()
 	}
 
-	if len(errors) == 3 {
-		for _, error := range errors {
-			log.Print(error)
+	ctx, c.StopWatching = context.WithCancel(ctx)
+	go c.watch(ctx, c.Clients, c.Auction)
+}
+
+// watch prints the updates of an auction as they arrive, moving on to the next server if the current one goes down.
+func (c *client) watch(ctx context.Context, clients []auction.AuctionClient, auctionId int32) {
+	for i := 0; ctx.Err() == nil; i = (i + 1) % len(clients) {
+		stream, error := clients[i].WatchAuction(ctx, &auction.WatchAuctionRequest{AuctionId: auctionId})
+		if error == nil {
+			error = c.receive(stream)
 		}
-	} else {
-		log.Printf("Successfully placed bid")
+
+		if error == nil {
+			return
+		}
+
+		if status.Code(error) != codes.Unavailable {
+			if ctx.Err() == nil {
+				log.Print(error)
+			}
+			return
+		}
+
+		time.Sleep(watchRetry)
+	}
+}
+
+func (c *client) receive(stream auction.Auction_WatchAuctionClient) error {
+	var highestBid int64 = -1
+	var ask int64 = -1
+
+	for {
+		response, error := stream.Recv()
+		if error == io.EOF {
+			return nil
+		}
+
+		if error != nil {
+			return error
+		}
+
+		// Only show the countdown every ten seconds and during the last five, but every new bid or price right away.
+		event, ok := response.Event.(*auction.ResultResponse_Status)
+		if ok && event.Status.HighestBid == highestBid && event.Status.Ask == ask && event.Status.Time%10 != 0 && event.Status.Time > 5 {
+			continue
+		}
+
+		if ok {
+			highestBid = event.Status.HighestBid
+			ask = event.Status.Ask
+		}
+
+		c.print(response)
 	}
 }
@@ -0,0 +1,37 @@
+package main
+
+import "context"
+
+// fanOut calls every server at the same time, each call with its own timeout. It returns the servers that answered,
+// in the order they answered, as soon as enough reports true for one of them or when the deadline has passed.
+// The calls that are still running are cancelled, and their results must not be read.
+func (c *client) fanOut(ctx context.Context, call func(ctx context.Context, i int), enough func(i int) bool) []int {
+	ctx, cancel := context.WithTimeout(ctx, c.Deadline)
+	defer cancel()
+
+	answers := make(chan int, len(c.Clients))
+	for i := range c.Clients {
+		go func(i int) {
+			ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+			defer cancel()
+
+			call(ctx, i)
+			answers <- i
+		}(i)
+	}
+
+	var answered []int
+	for len(answered) < len(c.Clients) {
+		select {
+		case i := <-answers:
+			answered = append(answered, i)
+			if enough(i) {
+				return answered
+			}
+		case <-ctx.Done():
+			return answered
+		}
+	}
+
+	return answered
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestFanOut(t *testing.T) {
+	const hang = time.Hour
+
+	tests := []struct {
+		name     string
+		delays   []time.Duration
+		enough   int
+		timeout  time.Duration
+		answered []int
+		timedOut []bool
+	}{
+		{"stops once enough answered", []time.Duration{20 * time.Millisecond, 0, hang}, 2, time.Second, []int{1, 0}, nil},
+		{"gives up at the deadline", []time.Duration{0, 20 * time.Millisecond, hang}, 3, time.Hour, []int{0, 1}, nil},
+		{"each call times out", []time.Duration{0, hang, 10 * time.Millisecond}, 3, 50 * time.Millisecond, []int{0, 2, 1}, []bool{false, true, false}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := Client("test", "password", false, auction.Role_BIDDER, "", 0, test.timeout, 200*time.Millisecond, insecure.NewCredentials(), nil)
+			c.Clients = make([]auction.AuctionClient, len(test.delays))
+
+			timedOut := make([]bool, len(test.delays))
+			count := 0
+			answered := c.fanOut(context.Background(), func(ctx context.Context, i int) {
+				select {
+				case <-time.After(test.delays[i]):
+				case <-ctx.Done():
+					timedOut[i] = true
+				}
+			}, func(i int) bool {
+				count++
+				return count >= test.enough
+			})
+
+			if !slices.Equal(answered, test.answered) {
+				t.Errorf("answered = %v, want %v", answered, test.answered)
+			}
+
+			if test.timedOut != nil && !slices.Equal(timedOut, test.timedOut) {
+				t.Errorf("timed out = %v, want %v", timedOut, test.timedOut)
+			}
+		})
+	}
+}
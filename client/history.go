@@ -0,0 +1,63 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"log"
+	"time"
+)
+
+// historyPage is how many bids a page of the history shows.
+const historyPage = 10
+
+// history prints a page of the bids in the current auction, oldest first.
+func (c *client) history(ctx context.Context, page int) {
+	for _, client := range c.Clients {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		response, error := client.BidHistory(call, &auction.BidHistoryRequest{
+			AuctionId: c.Auction,
+			Offset:    int64((page - 1) * historyPage),
+			Limit:     historyPage,
+		})
+		cancel()
+		if error != nil {
+			continue
+		}
+
+		if response.Total == 0 {
+			log.Printf("Nobody has bid in auction %d yet.", c.Auction)
+			return
+		}
+
+		pages := (response.Total + historyPage - 1) / historyPage
+		if int64(page) > pages {
+			log.Printf("There are only %d pages of bids in auction %d.", pages, c.Auction)
+			return
+		}
+
+		for _, record := range response.Records {
+			at := time.UnixMilli(record.Timestamp).Format(time.TimeOnly)
+			switch {
+			case record.RaisedMaximum:
+				log.Printf("%s %s raised their maximum bid", at, record.Name)
+			case record.Outcome == auction.Outcome_SUCCESS && record.Amount == 0:
+				log.Printf("%s %s placed a sealed bid", at, record.Name)
+			case record.Outcome == auction.Outcome_SUCCESS:
+				log.Printf("%s %s bid %d", at, record.Name, record.Amount)
+			case record.Amount == 0:
+				log.Printf("%s %s failed to bid: %s", at, record.Name, record.Reason)
+			default:
+				log.Printf("%s %s failed to bid %d: %s - %s", at, record.Name, record.Amount, record.Reason, record.Message)
+			}
+		}
+
+		if int64(page) < pages {
+			log.Printf("Page %d of %d. Write /history %d to see the next page.", page, pages, page+1)
+		} else {
+			log.Printf("Page %d of %d.", page, pages)
+		}
+		return
+	}
+
+	log.Printf("No response from the server")
+}
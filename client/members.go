@@ -0,0 +1,147 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// discover asks the servers it knows for the current members of the cluster and connects to them.
+// It reports whether any server answered.
+func (c *client) discover(ctx context.Context) bool {
+	for _, address := range c.Servers {
+		connection, error := grpc.Dial(address, grpc.WithTransportCredentials(c.Credentials))
+		if error != nil {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, discoverTimeout)
+		response, error := auction.NewMembershipClient(connection).Members(ctx, &auction.MembersRequest{})
+		cancel()
+		connection.Close()
+		if error != nil || len(response.Members) == 0 {
+			continue
+		}
+
+		c.connect(response.Members)
+		return true
+	}
+
+	return false
+}
+
+// connect replaces the connections of the client with connections to the servers.
+func (c *client) connect(servers []string) {
+	for _, connection := range c.Connections {
+		connection.Close()
+	}
+
+	c.Servers = servers
+	c.Connections = nil
+	c.Clients = nil
+	c.Accounts = nil
+	c.Memberships = nil
+	c.Detectors = nil
+
+	for _, address := range servers {
+		connection, error := grpc.Dial(address, grpc.WithTransportCredentials(c.Credentials))
+		if error != nil {
+			log.Fatalf("Connecting to server failed: %s", error)
+		}
+
+		c.Connections = append(c.Connections, connection)
+		c.Clients = append(c.Clients, auction.NewAuctionClient(connection))
+		c.Accounts = append(c.Accounts, auction.NewAuthenticationClient(connection))
+		c.Memberships = append(c.Memberships, auction.NewMembershipClient(connection))
+		c.Detectors = append(c.Detectors, auction.NewFailureDetectorClient(connection))
+	}
+}
+
+// refresh discovers the members again, and watches the auction on them.
+func (c *client) refresh(ctx context.Context) bool {
+	c.StopWatching()
+	ok := c.discover(ctx)
+	c.startWatching(ctx)
+
+	return ok
+}
+
+func (c *client) members(ctx context.Context) {
+	if !c.refresh(ctx) {
+		log.Printf("No response from the server")
+		return
+	}
+
+	log.Printf("Members: %s", strings.Join(c.Servers, ", "))
+}
+
+func (c *client) addPeer(ctx context.Context, address string) {
+	var errors []error
+	for _, client := range c.Memberships {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		_, error := client.AddPeer(call, &auction.AddPeerRequest{Address: address})
+		cancel()
+		if error == nil {
+			log.Printf("Added %s to the cluster", address)
+			c.refresh(ctx)
+			return
+		}
+
+		errors = append(errors, error)
+	}
+
+	for _, error := range errors {
+		log.Print(error)
+	}
+}
+
+func (c *client) removePeer(ctx context.Context, address string) {
+	var errors []error
+	for _, client := range c.Memberships {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		_, error := client.RemovePeer(call, &auction.RemovePeerRequest{Address: address})
+		cancel()
+		if error == nil {
+			log.Printf("Removed %s from the cluster", address)
+			c.refresh(ctx)
+			return
+		}
+
+		errors = append(errors, error)
+	}
+
+	for _, error := range errors {
+		log.Print(error)
+	}
+}
+
+// health prints which servers each server believes are alive.
+func (c *client) health(ctx context.Context) {
+	for i, client := range c.Detectors {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		response, error := client.View(call, &auction.ViewRequest{})
+		cancel()
+		if error != nil {
+			log.Printf("%s is not responding", c.Servers[i])
+			continue
+		}
+
+		var peers []string
+		for _, peer := range response.Peers {
+			if peer.Alive {
+				peers = append(peers, fmt.Sprintf("%d alive", peer.Port))
+			} else if peer.LastHeard == 0 {
+				peers = append(peers, fmt.Sprintf("%d suspected", peer.Port))
+			} else {
+				peers = append(peers, fmt.Sprintf("%d suspected - last heard %d seconds ago", peer.Port, time.Since(time.UnixMilli(peer.LastHeard))/time.Second))
+			}
+		}
+
+		log.Printf("Server %d: %s", response.Port, strings.Join(peers, ", "))
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"crypto/rand"
+	"log"
+)
+
+// sealedBid is a bid the client has committed to, which it reveals once the bidding is over.
+type sealedBid struct {
+	Amount int64
+	Nonce  []byte
+}
+
+// commit sends only the commitment to the bid, so the servers can not see the amount until it is revealed.
+func (c *client) commit(ctx context.Context, amount int64) {
+	var errors []error
+
+	nonce := make([]byte, 32)
+	_, error := rand.Read(nonce)
+	if error != nil {
+		log.Printf("Failed to generate a nonce: %s", error)
+		return
+	}
+
+	// Every server gets the same request id, so a retry is not taken as a second bid.
+	request := &auction.CommitRequest{AuctionId: c.Auction, Commitment: auction.Commitment(c.Auction, int32(c.Id), amount, nonce), RequestId: requestId()}
+	for _, client := range c.Clients {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		_, error := client.Commit(call, request)
+		cancel()
+		if error == nil {
+			c.Commitments[c.Auction] = sealedBid{Amount: amount, Nonce: nonce}
+			log.Printf("SUCCESS: committed to a bid of %d. Write /reveal once the bidding is over.", amount)
+			log.Printf("If the client is restarted, write /reveal %d %x instead.", amount, nonce)
+			return
+		}
+
+		errors = append(errors, error)
+	}
+
+	for _, error := range errors {
+		log.Print(error)
+	}
+}
+
+// reveal sends the amount and the nonce of the bid the client committed to, so the servers can check them against the commitment.
+func (c *client) reveal(ctx context.Context, bid sealedBid) {
+	// Every server gets the same request id, so a retry gets the outcome of the first reveal.
+	request := &auction.RevealRequest{AuctionId: c.Auction, Amount: bid.Amount, Nonce: bid.Nonce, RequestId: requestId()}
+
+	var errors []error
+	for _, client := range c.Clients {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		_, error := client.Reveal(call, request)
+		cancel()
+		if error == nil {
+			delete(c.Commitments, c.Auction)
+			log.Printf("SUCCESS: revealed the bid of %d", bid.Amount)
+			return
+		}
+
+		errors = append(errors, error)
+	}
+
+	for _, error := range errors {
+		log.Print(error)
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var caFile = flag.String("ca", "", "The certificate authority the certificates of the servers are signed by. Without it the connections are not encrypted")
+
+// loadCredentials returns the credentials the client connects to the servers with. The client only trusts servers
+// with a certificate from the certificate authority.
+func loadCredentials() (credentials.TransportCredentials, error) {
+	if *caFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	authority, error := os.ReadFile(*caFile)
+	if error != nil {
+		return nil, error
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(authority) {
+		return nil, fmt.Errorf("%s has no certificates", *caFile)
+	}
+
+	return credentials.NewTLS(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}), nil
+}
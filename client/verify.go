@@ -0,0 +1,101 @@
+package main
+
+import (
+	"auction/auction"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc/status"
+)
+
+// verify checks the ledger of the current auction on every server, and whether the servers have the same head.
+// A server that changed the highest bid either has a ledger that does not match its hashes, or a different head than the others.
+// Once the auction is over, the result has to be the newest entry of the ledger.
+func (c *client) verify(ctx context.Context) {
+	heads := make(map[string][]string)
+	for i, client := range c.Clients {
+		call, cancel := context.WithTimeout(ctx, c.Timeout)
+		response, error := client.Ledger(call, &auction.LedgerRequest{AuctionId: c.Auction})
+		var result *auction.ResultResponse
+		if error == nil {
+			result, error = client.Result(call, &auction.ResultRequest{AuctionId: c.Auction})
+		}
+		cancel()
+		if error != nil {
+			log.Printf("%s: %s", c.Servers[i], status.Convert(error).Message())
+			continue
+		}
+
+		error = check(response)
+		if error == nil && result.GetWinner() != nil {
+			error = settled(response, result.GetWinner())
+		}
+
+		if error != nil {
+			log.Printf("%s: the ledger has been tampered with - %s", c.Servers[i], error)
+			continue
+		}
+
+		head := hex.EncodeToString(response.Head)
+		log.Printf("%s: %d changes of the highest bid - head: %.16s", c.Servers[i], len(response.Entries), head)
+		heads[head] = append(heads[head], c.Servers[i])
+	}
+
+	switch len(heads) {
+	case 0:
+		log.Printf("No server has a valid ledger")
+	case 1:
+		log.Printf("The ledgers are valid and every server that answered has the same head")
+	default:
+		log.Printf("The servers have different heads:")
+		for head, servers := range heads {
+			log.Printf("%.16s: %s", head, strings.Join(servers, ", "))
+		}
+	}
+}
+
+// check recomputes the hashes of the ledger, and returns an error if an entry or the head does not match them.
+func check(ledger *auction.LedgerResponse) error {
+	var previous []byte
+	for i, entry := range ledger.Entries {
+		if !bytes.Equal(entry.Previous, previous) {
+			return fmt.Errorf("entry %d is not chained to the entry before it", i)
+		}
+
+		if !bytes.Equal(entry.Hash, auction.Chain(previous, entry.Record)) {
+			return fmt.Errorf("entry %d does not match its hash - %s leads with %d", i, entry.Record.GetName(), entry.Record.GetHighestBid())
+		}
+
+		previous = entry.Hash
+	}
+
+	if !bytes.Equal(ledger.Head, previous) {
+		return fmt.Errorf("the head is not the hash of the newest entry")
+	}
+
+	return nil
+}
+
+// settled returns an error if the winner of the auction is not the final state in the newest entry of the ledger.
+func settled(ledger *auction.LedgerResponse, winner *auction.ResultResponse_WinnerMessage) error {
+	if len(ledger.Entries) == 0 {
+		return fmt.Errorf("the auction is over, but the ledger is empty")
+	}
+
+	newest := ledger.Entries[len(ledger.Entries)-1].Record
+	if !newest.GetFinished() {
+		return fmt.Errorf("the auction is over, but the ledger does not end with its result")
+	}
+
+	// Below the reserve price nobody wins, so the result has no name.
+	if newest.GetHighestBid() != winner.Amount || newest.GetPrice() != winner.Price || (winner.Name != "" && newest.GetName() != winner.Name) {
+		return fmt.Errorf("the result is %s with %d for %d, but the ledger ends with %s with %d for %d",
+			winner.Name, winner.Amount, winner.Price, newest.GetName(), newest.GetHighestBid(), newest.GetPrice())
+	}
+
+	return nil
+}
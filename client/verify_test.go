@@ -0,0 +1,90 @@
+package main
+
+import (
+	"auction/auction"
+	"testing"
+)
+
+// testLedger chains the records into a ledger the way the servers do.
+func testLedger(records ...*auction.LedgerRecord) *auction.LedgerResponse {
+	ledger := &auction.LedgerResponse{}
+	for _, record := range records {
+		hash := auction.Chain(ledger.Head, record)
+		ledger.Entries = append(ledger.Entries, &auction.LedgerEntry{Record: record, Previous: ledger.Head, Hash: hash})
+		ledger.Head = hash
+	}
+
+	return ledger
+}
+
+func testRecords() []*auction.LedgerRecord {
+	return []*auction.LedgerRecord{
+		{Timestamp: 1, BidderId: 2, Name: "alice", HighestBid: 55},
+		{Timestamp: 2, BidderId: 2, Name: "alice", HighestBid: 59},
+		{Timestamp: 3, BidderId: 3, Name: "bob", HighestBid: 64},
+		{Timestamp: 4, BidderId: 3, Name: "bob", HighestBid: 64, Price: 64, Finished: true},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(ledger *auction.LedgerResponse)
+		valid  bool
+	}{
+		{"valid", func(ledger *auction.LedgerResponse) {}, true},
+		{"empty", func(ledger *auction.LedgerResponse) {
+			ledger.Entries = nil
+			ledger.Head = nil
+		}, true},
+		{"changed highest bid", func(ledger *auction.LedgerResponse) { ledger.Entries[1].Record.HighestBid = 58 }, false},
+		{"changed bidder", func(ledger *auction.LedgerResponse) { ledger.Entries[2].Record.Name = "mallory" }, false},
+		{"removed entry", func(ledger *auction.LedgerResponse) {
+			ledger.Entries = append(ledger.Entries[:1], ledger.Entries[2:]...)
+		}, false},
+		{"rehashed entry", func(ledger *auction.LedgerResponse) {
+			entry := ledger.Entries[1]
+			entry.Record.HighestBid = 58
+			entry.Hash = auction.Chain(entry.Previous, entry.Record)
+		}, false},
+		{"wrong head", func(ledger *auction.LedgerResponse) { ledger.Head = ledger.Entries[2].Hash }, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ledger := testLedger(testRecords()...)
+			test.tamper(ledger)
+
+			error := check(ledger)
+			if (error == nil) != test.valid {
+				t.Errorf("check = %v, want valid: %t", error, test.valid)
+			}
+		})
+	}
+}
+
+func TestSettled(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []*auction.LedgerRecord
+		winner  *auction.ResultResponse_WinnerMessage
+		valid   bool
+	}{
+		{"matches", testRecords(), &auction.ResultResponse_WinnerMessage{Name: "bob", Amount: 64, Price: 64}, true},
+		{"below the reserve", testRecords(), &auction.ResultResponse_WinnerMessage{Amount: 64, Price: 64}, true},
+		{"other winner", testRecords(), &auction.ResultResponse_WinnerMessage{Name: "alice", Amount: 64, Price: 64}, false},
+		{"other amount", testRecords(), &auction.ResultResponse_WinnerMessage{Name: "bob", Amount: 70, Price: 64}, false},
+		{"other price", testRecords(), &auction.ResultResponse_WinnerMessage{Name: "bob", Amount: 64, Price: 59}, false},
+		{"not finished", testRecords()[:3], &auction.ResultResponse_WinnerMessage{Name: "bob", Amount: 64, Price: 64}, false},
+		{"empty", nil, &auction.ResultResponse_WinnerMessage{Amount: 50, Price: 50}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			error := settled(testLedger(test.records...), test.winner)
+			if (error == nil) != test.valid {
+				t.Errorf("settled = %v, want valid: %t", error, test.valid)
+			}
+		})
+	}
+}
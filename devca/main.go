@@ -0,0 +1,113 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var out = flag.String("out", "certs", "The directory the certificates are written to")
+var hosts = flag.String("hosts", "localhost,127.0.0.1,::1", "The host names and IP addresses the servers are reached at, separated by commas")
+var days = flag.Int("days", 365, "How many days the certificates are valid")
+
+// devca generates a certificate authority and a certificate signed by it for testing the servers locally.
+// The certificate can be used both to serve and as a client certificate, so every server can use the same one.
+// It is only meant for development, since the private keys are written unencrypted.
+func main() {
+	flag.Parse()
+
+	error := os.MkdirAll(*out, 0755)
+	if error != nil {
+		log.Fatalf("Failed to create %s: %s", *out, error)
+	}
+
+	notAfter := time.Now().Add(time.Duration(*days) * 24 * time.Hour)
+
+	authorityKey, error := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if error != nil {
+		log.Fatalf("Failed to generate a key: %s", error)
+	}
+
+	authority := &x509.Certificate{
+		SerialNumber:          serialNumber(),
+		Subject:               pkix.Name{CommonName: "Auction development CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	authorityDer, error := x509.CreateCertificate(rand.Reader, authority, authority, &authorityKey.PublicKey, authorityKey)
+	if error != nil {
+		log.Fatalf("Failed to create the certificate authority: %s", error)
+	}
+
+	serverKey, error := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if error != nil {
+		log.Fatalf("Failed to generate a key: %s", error)
+	}
+
+	certificate := &x509.Certificate{
+		SerialNumber: serialNumber(),
+		Subject:      pkix.Name{CommonName: "Auction server"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	for _, host := range strings.Split(*hosts, ",") {
+		ip := net.ParseIP(host)
+		if ip != nil {
+			certificate.IPAddresses = append(certificate.IPAddresses, ip)
+		} else {
+			certificate.DNSNames = append(certificate.DNSNames, host)
+		}
+	}
+
+	serverDer, error := x509.CreateCertificate(rand.Reader, certificate, authority, &serverKey.PublicKey, authorityKey)
+	if error != nil {
+		log.Fatalf("Failed to create the server certificate: %s", error)
+	}
+
+	serverKeyDer, error := x509.MarshalECPrivateKey(serverKey)
+	if error != nil {
+		log.Fatalf("Failed to encode the key: %s", error)
+	}
+
+	write("ca.pem", "CERTIFICATE", authorityDer, 0644)
+	write("server.pem", "CERTIFICATE", serverDer, 0644)
+	write("server-key.pem", "EC PRIVATE KEY", serverKeyDer, 0600)
+
+	log.Printf("Wrote ca.pem, server.pem and server-key.pem to %s", *out)
+}
+
+func serialNumber() *big.Int {
+	serial, error := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if error != nil {
+		log.Fatalf("Failed to generate a serial number: %s", error)
+	}
+
+	return serial
+}
+
+func write(name string, kind string, der []byte, mode os.FileMode) {
+	data := pem.EncodeToMemory(&pem.Block{Type: kind, Bytes: der})
+
+	error := os.WriteFile(filepath.Join(*out, name), data, mode)
+	if error != nil {
+		log.Fatalf("Failed to write %s: %s", name, error)
+	}
+}
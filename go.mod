@@ -3,6 +3,7 @@ module auction
 go 1.21.0
 
 require (
+	golang.org/x/crypto v0.15.0
 	google.golang.org/grpc v1.59.0
 	google.golang.org/protobuf v1.31.0
 )
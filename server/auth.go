@@ -0,0 +1,200 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var secret = flag.String("secret", "", "The key the tokens and the calls between the servers are signed with. Every server needs the same key")
+var development = flag.Bool("dev", false, "Use an insecure development key when no -secret is given")
+var tokenLifetime = flag.Duration("token-lifetime", 24*time.Hour, "How long a token is valid")
+var auctioneerCode = flag.String("auctioneer-code", "", "The code a user has to give to register as an auctioneer. Without it nobody can register as an auctioneer")
+
+// account is a registered user. Its id is the index of the log entry that registered it, so it is the same on every server.
+type account struct {
+	Id   int32
+	Name string
+	Hash []byte
+	Role auction.Role
+}
+
+// identity is what a token says about the user who sent it.
+type identity struct {
+	Id      int32        `json:"id"`
+	Name    string       `json:"name"`
+	Role    auction.Role `json:"role"`
+	Expires int64        `json:"expires"`
+}
+
+// loadSecret returns the key the tokens are signed with. A token signed by one server is accepted by every server with the same key.
+// Anyone who knows the key can sign tokens, so the development key is only used when -dev is given.
+func loadSecret() ([]byte, error) {
+	if *secret != "" {
+		return []byte(*secret), nil
+	}
+
+	if !*development {
+		return nil, fmt.Errorf("-secret has to be given, or -dev to use an insecure development key")
+	}
+
+	log.Printf("No -secret was given, so the tokens are signed with an insecure development key")
+	return []byte("development"), nil
+}
+
+func (s *server) Register(ctx context.Context, request *auction.RegisterRequest) (*auction.TokenResponse, error) {
+	name := strings.TrimSpace(request.Name)
+	if name == "" || request.Password == "" {
+		return &auction.TokenResponse{}, status.Errorf(codes.InvalidArgument, "a user needs a name and a password")
+	}
+
+	_, known := auction.Role_name[int32(request.Role)]
+	if !known {
+		return &auction.TokenResponse{}, status.Errorf(codes.InvalidArgument, "%d is not a role", request.Role)
+	}
+
+	if request.Role == auction.Role_AUCTIONEER {
+		if *auctioneerCode == "" {
+			return &auction.TokenResponse{}, status.Errorf(codes.PermissionDenied, "the servers were started without an auctioneer code, so nobody can register as an auctioneer")
+		}
+
+		if subtle.ConstantTimeCompare([]byte(request.Code), []byte(*auctioneerCode)) != 1 {
+			return &auction.TokenResponse{}, status.Errorf(codes.PermissionDenied, "the code to register as an auctioneer is wrong")
+		}
+	}
+
+	s.BidMutex.Lock()
+	_, taken := s.Accounts[name]
+	s.BidMutex.Unlock()
+	if taken {
+		return &auction.TokenResponse{}, status.Errorf(codes.AlreadyExists, "the name %s is taken", name)
+	}
+
+	// bcrypt is salted and slow, so the hashes in the log can not easily be guessed back to the passwords.
+	hash, error := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if error != nil {
+		return &auction.TokenResponse{}, status.Errorf(codes.InvalidArgument, "the password can not be used: %s", error)
+	}
+
+	_, error = s.commit(ctx, &auction.LogEntry{Event: &auction.LogEntry_Register{Register: &auction.LogEntry_RegisterMessage{
+		Name: name,
+		Hash: hash,
+		Role: request.Role,
+	}}})
+	if error != nil {
+		return &auction.TokenResponse{}, error
+	}
+
+	return s.Login(ctx, &auction.LoginRequest{Name: name, Password: request.Password})
+}
+
+// Login returns a token for the user. Every server has the accounts, so any of them can log a user in.
+func (s *server) Login(_ context.Context, request *auction.LoginRequest) (*auction.TokenResponse, error) {
+	error := s.ready()
+	if error != nil {
+		return &auction.TokenResponse{}, error
+	}
+
+	s.BidMutex.Lock()
+	account, ok := s.Accounts[strings.TrimSpace(request.Name)]
+	s.BidMutex.Unlock()
+
+	if !ok || bcrypt.CompareHashAndPassword(account.Hash, []byte(request.Password)) != nil {
+		return &auction.TokenResponse{}, status.Errorf(codes.Unauthenticated, "wrong name or password")
+	}
+
+	token, error := s.sign(identity{Id: account.Id, Name: account.Name, Role: account.Role, Expires: time.Now().Add(*tokenLifetime).Unix()})
+	if error != nil {
+		return &auction.TokenResponse{}, status.Errorf(codes.Internal, "failed to sign the token: %s", error)
+	}
+
+	return &auction.TokenResponse{Token: token, Id: account.Id, Role: account.Role}, nil
+}
+
+// register adds the account from the log. If two users registered the same name, the first one gets it.
+func (s *server) register(id int32, message *auction.LogEntry_RegisterMessage) error {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	_, taken := s.Accounts[message.Name]
+	if taken {
+		return status.Errorf(codes.AlreadyExists, "the name %s is taken", message.Name)
+	}
+
+	log.Printf("User %d registered as %s: %s", id, strings.ToLower(message.Role.String()), message.Name)
+	s.Accounts[message.Name] = &account{Id: id, Name: message.Name, Hash: message.Hash, Role: message.Role}
+
+	return nil
+}
+
+// sign returns a token with the identity and a signature of it, as "<identity>.<signature>" in base64.
+func (s *server) sign(identity identity) (string, error) {
+	payload, error := json.Marshal(identity)
+	if error != nil {
+		return "", error
+	}
+
+	mac := hmac.New(sha256.New, s.Secret)
+	mac.Write(payload)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// verify returns the identity in the token if it was signed with the secret and has not expired.
+func (s *server) verify(token string) (identity, error) {
+	var user identity
+
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	payload, payloadError := base64.RawURLEncoding.DecodeString(encodedPayload)
+	signature, signatureError := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if !ok || payloadError != nil || signatureError != nil {
+		return user, status.Errorf(codes.Unauthenticated, "the token is malformed")
+	}
+
+	mac := hmac.New(sha256.New, s.Secret)
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return user, status.Errorf(codes.Unauthenticated, "the token has an invalid signature")
+	}
+
+	error := json.Unmarshal(payload, &user)
+	if error != nil {
+		return user, status.Errorf(codes.Unauthenticated, "the token is malformed")
+	}
+
+	if time.Now().Unix() > user.Expires {
+		return user, status.Errorf(codes.Unauthenticated, "the token has expired, so log in again")
+	}
+
+	return user, nil
+}
+
+// authenticate returns the identity of the user from the token in the "authorization" metadata of the call.
+// The user is who the token says, whatever the request claims.
+func (s *server) authenticate(ctx context.Context) (identity, error) {
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) == 0 {
+		return identity{}, status.Errorf(codes.Unauthenticated, "log in first")
+	}
+
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return identity{}, status.Errorf(codes.Unauthenticated, "the authorization is not a bearer token")
+	}
+
+	return s.verify(token)
+}
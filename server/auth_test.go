@@ -0,0 +1,121 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"encoding/base64"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerify(t *testing.T) {
+	s := testServer(testRules())
+	other := Server(5001, []string{"localhost:5001"}, testRules(), []byte("other"), insecure.NewCredentials(), insecure.NewCredentials())
+
+	valid, _ := s.sign(identity{Id: 1, Name: "alice", Expires: time.Now().Add(time.Hour).Unix()})
+	expired, _ := s.sign(identity{Id: 1, Name: "alice", Expires: time.Now().Add(-time.Hour).Unix()})
+	foreign, _ := other.sign(identity{Id: 1, Name: "alice", Expires: time.Now().Add(time.Hour).Unix()})
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"valid", valid, true},
+		{"expired", expired, false},
+		{"signed with another secret", foreign, false},
+		{"malformed", "token", false},
+		{"not base64", "a!.b!", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bidder, error := s.verify(test.token)
+			if test.valid {
+				if error != nil || bidder.Id != 1 || bidder.Name != "alice" {
+					t.Errorf("verify = %+v, %v, want bidder 1 alice", bidder, error)
+				}
+			} else if status.Code(error) != codes.Unauthenticated {
+				t.Errorf("verify = %+v, %v, want Unauthenticated", bidder, error)
+			}
+		})
+	}
+}
+
+func TestVerifyPeer(t *testing.T) {
+	s := testServer(testRules())
+	other := Server(5001, []string{"localhost:5001"}, testRules(), []byte("other"), insecure.NewCredentials(), insecure.NewCredentials())
+
+	method := "/auction.Replication/AppendEntries"
+	request := &auction.AppendEntriesMessage{Term: 2, Port: 5001}
+	now := time.Now().UnixMilli()
+
+	// header returns the metadata signPeer would send for the request, signed by the server at the timestamp.
+	header := func(signer *server, timestamp int64, request *auction.AppendEntriesMessage) string {
+		signature, _ := signer.signature(method, timestamp, request)
+		return strconv.FormatInt(timestamp, 10) + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+
+	tests := []struct {
+		name      string
+		signature string
+		valid     bool
+	}{
+		{"signed", header(s, now, request), true},
+		{"unsigned", "", false},
+		{"signed with another secret", header(other, now, request), false},
+		{"signed for another request", header(s, now, &auction.AppendEntriesMessage{Term: 3, Port: 5001}), false},
+		{"too old", header(s, now-2*signatureAge.Milliseconds(), request), false},
+		{"malformed", "signature", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.signature != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("peer-signature", test.signature))
+			}
+
+			error := s.verifyPeer(ctx, method, request)
+			if test.valid && error != nil {
+				t.Errorf("verifyPeer = %v, want nil", error)
+			} else if !test.valid && status.Code(error) != codes.PermissionDenied {
+				t.Errorf("verifyPeer = %v, want PermissionDenied", error)
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		request *auction.RegisterRequest
+	}{
+		{"unknown role", "", &auction.RegisterRequest{Name: "alice", Password: "password", Role: 7}},
+		{"auctioneer without an auctioneer code", "", &auction.RegisterRequest{Name: "alice", Password: "password", Role: auction.Role_AUCTIONEER}},
+		{"auctioneer with the wrong code", "code", &auction.RegisterRequest{Name: "alice", Password: "password", Role: auction.Role_AUCTIONEER, Code: "guess"}},
+		{"no password", "", &auction.RegisterRequest{Name: "alice"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			previous := *auctioneerCode
+			*auctioneerCode = test.code
+			defer func() { *auctioneerCode = previous }()
+
+			s := testServer(testRules())
+
+			_, error := s.Register(context.Background(), test.request)
+			if error == nil {
+				t.Errorf("Register succeeded, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const catchUpTimeout = 2 * time.Second
+
+// CatchUp sends a follower a snapshot of the leader's auctions. The leader applies every entry as soon as it is committed,
+// so the snapshot covers all of them.
+func (s *server) CatchUp(_ context.Context, request *auction.CatchUpRequest) (*auction.CatchUpResponse, error) {
+	s.RaftMutex.Lock()
+	defer s.RaftMutex.Unlock()
+
+	if s.Role != leader {
+		return &auction.CatchUpResponse{}, fmt.Errorf("server %d is not the leader - leader: %d", s.Port, s.Leader)
+	}
+
+	log.Printf("Server %d is catching up from index %d", request.Port, s.LastApplied)
+
+	return &auction.CatchUpResponse{
+		Term:     s.Term,
+		Snapshot: s.capture(),
+	}, nil
+}
+
+// catchUp fetches the state of the auctions from the leader. Until it has, the server does not answer bidders,
+// so a server that was down or joins late never reports an outdated result.
+func (s *server) catchUp() {
+	s.RaftMutex.Lock()
+	if s.CatchingUp {
+		s.RaftMutex.Unlock()
+		return
+	}
+	s.CatchingUp = true
+	s.Ready = s.Role == leader
+	s.RaftMutex.Unlock()
+
+	retry := time.NewTicker(heartbeatInterval)
+	defer retry.Stop()
+
+	for ; ; <-retry.C {
+		s.RaftMutex.Lock()
+		if s.Role == leader {
+			s.Ready = true
+			s.CatchingUp = false
+			s.RaftMutex.Unlock()
+			return
+		}
+		leader := s.Leader
+		peer, ok := s.Peers[leader]
+		s.RaftMutex.Unlock()
+
+		if !ok {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), catchUpTimeout)
+		response, error := peer.CatchUp(ctx, &auction.CatchUpRequest{Port: int32(s.Port)})
+		cancel()
+		if error != nil {
+			continue
+		}
+
+		s.RaftMutex.Lock()
+		s.install(response)
+		s.Ready = true
+		s.CatchingUp = false
+		s.RaftMutex.Unlock()
+
+		log.Printf("Caught up with leader %d at index %d", leader, response.Snapshot.LastIndex)
+		return
+	}
+}
+
+// install applies the leader's snapshot if it is newer than the auctions of this server. RaftMutex must be held.
+func (s *server) install(response *auction.CatchUpResponse) {
+	if response.Term > s.Term {
+		s.follow(response.Term)
+	}
+
+	snapshot := response.Snapshot
+	if snapshot.LastIndex <= s.LastApplied {
+		return
+	}
+
+	// Entries after the snapshot are kept if the log agrees with the leader at the end of the snapshot.
+	var rest []*auction.LogEntry
+	if snapshot.LastIndex <= s.lastIndex() && s.entry(snapshot.LastIndex).Term == snapshot.LastTerm {
+		rest = s.Log[snapshot.LastIndex-s.LogStart+1:]
+	}
+
+	s.restore(snapshot)
+	s.Log = append(s.Log, rest...)
+	s.save(snapshot)
+	s.configure()
+	s.resume()
+}
+
+// ready fails until the server has caught up with the leader.
+func (s *server) ready() error {
+	s.RaftMutex.Lock()
+	defer s.RaftMutex.Unlock()
+
+	if !s.Ready {
+		return status.Errorf(codes.Unavailable, "server %d is catching up with the leader", s.Port)
+	}
+
+	return nil
+}
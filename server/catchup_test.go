@@ -0,0 +1,64 @@
+package main
+
+import (
+	"auction/auction"
+	"slices"
+	"testing"
+)
+
+func TestInstall(t *testing.T) {
+	tests := []struct {
+		name      string
+		log       []int64
+		applied   int64
+		lastIndex int64
+		lastTerm  int64
+		logStart  int64
+		terms     []int64
+		restored  bool
+	}{
+		{"behind the snapshot", []int64{1, 1}, 2, 5, 2, 5, nil, true},
+		{"entries after the snapshot are kept", []int64{1, 1, 2, 2}, 1, 3, 2, 3, []int64{2}, true},
+		{"entries after a conflicting snapshot are dropped", []int64{1, 1, 1, 1}, 1, 3, 2, 3, nil, true},
+		{"older snapshot", []int64{1, 1, 2}, 3, 2, 1, 0, []int64{1, 1, 2}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testRaft(test.log...)
+			s.Term = 2
+			s.CommitIndex = test.applied
+			s.LastApplied = test.applied
+
+			s.install(&auction.CatchUpResponse{
+				Term: 3,
+				Snapshot: &auction.SnapshotMessage{
+					LastIndex: test.lastIndex,
+					LastTerm:  test.lastTerm,
+					Auctions:  []*auction.LotMessage{{AuctionId: 0, Name: "Test", Rules: s.Rules, HighestBid: 70, Started: true, Finished: true}},
+				},
+			})
+
+			if s.Term != 3 {
+				t.Errorf("term = %d, want 3", s.Term)
+			}
+
+			if s.LogStart != test.logStart {
+				t.Errorf("log starts at %d, want %d", s.LogStart, test.logStart)
+			}
+
+			if terms := logTerms(s); !slices.Equal(terms, test.terms) {
+				t.Errorf("log = %v, want %v", terms, test.terms)
+			}
+
+			_, ok := s.Auctions[0]
+			if ok != test.restored {
+				t.Errorf("auction 0 restored: %t, want %t", ok, test.restored)
+			}
+
+			if test.restored && (s.CommitIndex != test.lastIndex || s.LastApplied != test.lastIndex) {
+				t.Errorf("%d committed and %d applied, want %d", s.CommitIndex, s.LastApplied, test.lastIndex)
+			}
+		})
+	}
+}
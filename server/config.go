@@ -0,0 +1,176 @@
+package main
+
+import (
+	"auction/auction"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+)
+
+var configFile = flag.String("config", "", "A JSON file with the rules of the auction. Flags that are set override it")
+var startingBid = flag.Int64("starting-bid", 50, "The price the auction starts at")
+var duration = flag.Int64("duration", 120, "How many seconds the auction runs")
+var increment = flag.Int64("increment", 1, "How much a bid has to raise the highest bid")
+var reserve = flag.Int64("reserve", 0, "The lowest winning bid. Below it the auction ends without a winner")
+var start = flag.String("start", "first-bid", "When the auction starts: first-bid, at or manual")
+var startAt = flag.String("start-at", "", "When the auction starts if -start is at, in RFC 3339 format")
+var extensionWindow = flag.Int64("extension-window", 0, "Bids in this many final seconds extend the auction")
+var extension = flag.Int64("extension", 0, "How many seconds a late bid extends the auction by")
+var auctionType = flag.String("type", "english", "The type of auction: english, sealed, vickrey or dutch")
+var dropInterval = flag.Int64("drop-interval", 1, "How many seconds pass between the price drops of a dutch auction")
+var revealWindow = flag.Int64("reveal-window", 30, "How many seconds the bidders of a sealed auction have to reveal their bids after the bidding")
+
+type config struct {
+	StartingBid int64  `json:"startingBid"`
+	Duration    int64  `json:"duration"`
+	Increment   int64  `json:"increment"`
+	Reserve     int64  `json:"reserve"`
+	Start       string `json:"start"`
+	StartAt     string `json:"startAt"`
+
+	ExtensionWindow int64 `json:"extensionWindow"`
+	Extension       int64 `json:"extension"`
+
+	Type         string `json:"type"`
+	DropInterval int64  `json:"dropInterval"`
+	RevealWindow int64  `json:"revealWindow"`
+}
+
+func loadRules() (*auction.Rules, error) {
+	c := config{
+		StartingBid: *startingBid,
+		Duration:    *duration,
+		Increment:   *increment,
+		Reserve:     *reserve,
+		Start:       *start,
+		StartAt:     *startAt,
+
+		ExtensionWindow: *extensionWindow,
+		Extension:       *extension,
+
+		Type:         *auctionType,
+		DropInterval: *dropInterval,
+		RevealWindow: *revealWindow,
+	}
+
+	if *configFile != "" {
+		data, error := os.ReadFile(*configFile)
+		if error != nil {
+			return nil, error
+		}
+
+		error = json.Unmarshal(data, &c)
+		if error != nil {
+			return nil, fmt.Errorf("invalid config file: %s", error)
+		}
+	}
+
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "starting-bid":
+			c.StartingBid = *startingBid
+		case "duration":
+			c.Duration = *duration
+		case "increment":
+			c.Increment = *increment
+		case "reserve":
+			c.Reserve = *reserve
+		case "start":
+			c.Start = *start
+		case "start-at":
+			c.StartAt = *startAt
+		case "extension-window":
+			c.ExtensionWindow = *extensionWindow
+		case "extension":
+			c.Extension = *extension
+		case "type":
+			c.Type = *auctionType
+		case "drop-interval":
+			c.DropInterval = *dropInterval
+		case "reveal-window":
+			c.RevealWindow = *revealWindow
+		}
+	})
+
+	return c.rules()
+}
+
+func (c config) rules() (*auction.Rules, error) {
+	rules := &auction.Rules{
+		StartingBid: c.StartingBid,
+		Duration:    c.Duration,
+		Increment:   c.Increment,
+		Reserve:     c.Reserve,
+
+		ExtensionWindow: c.ExtensionWindow,
+		Extension:       c.Extension,
+
+		DropInterval: c.DropInterval,
+		RevealWindow: c.RevealWindow,
+	}
+
+	switch c.Start {
+	case "first-bid":
+		rules.Start = auction.StartMode_FIRST_BID
+	case "at":
+		rules.Start = auction.StartMode_AT_TIME
+
+		at, error := time.Parse(time.RFC3339, c.StartAt)
+		if error != nil {
+			return nil, fmt.Errorf("invalid start time: %s", error)
+		}
+		rules.StartAt = at.Unix()
+	case "manual":
+		rules.Start = auction.StartMode_MANUAL
+	default:
+		return nil, fmt.Errorf("unknown start mode: %s", c.Start)
+	}
+
+	switch c.Type {
+	case "english":
+		rules.Type = auction.AuctionType_ENGLISH
+	case "sealed":
+		rules.Type = auction.AuctionType_SEALED
+	case "vickrey":
+		rules.Type = auction.AuctionType_VICKREY
+	case "dutch":
+		rules.Type = auction.AuctionType_DUTCH
+	default:
+		return nil, fmt.Errorf("unknown auction type: %s", c.Type)
+	}
+
+	return rules, validateRules(rules)
+}
+
+func validateRules(rules *auction.Rules) error {
+	if rules.Duration <= 0 {
+		return fmt.Errorf("the duration has to be positive")
+	}
+
+	if rules.Increment <= 0 {
+		return fmt.Errorf("the increment has to be positive")
+	}
+
+	if rules.ExtensionWindow < 0 || rules.Extension < 0 {
+		return fmt.Errorf("the extension window and the extension can not be negative")
+	}
+
+	sealed := rules.Type == auction.AuctionType_SEALED || rules.Type == auction.AuctionType_VICKREY
+	if sealed && rules.RevealWindow <= 0 {
+		return fmt.Errorf("the reveal window of a sealed auction has to be positive")
+	}
+
+	if rules.Type == auction.AuctionType_DUTCH {
+		if rules.Start == auction.StartMode_FIRST_BID {
+			return fmt.Errorf("a dutch auction has to start at a time or manually")
+		}
+
+		if rules.DropInterval <= 0 {
+			return fmt.Errorf("the drop interval has to be positive")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"auction/auction"
+	"testing"
+)
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		config config
+		start  auction.StartMode
+		valid  bool
+	}{
+		{"first bid", config{Duration: 120, Increment: 1, Type: "english", Start: "first-bid"}, auction.StartMode_FIRST_BID, true},
+		{"manual", config{Duration: 120, Increment: 1, Type: "english", Start: "manual"}, auction.StartMode_MANUAL, true},
+		{"at", config{Duration: 120, Increment: 1, Type: "english", Start: "at", StartAt: "2023-11-30T12:00:00Z"}, auction.StartMode_AT_TIME, true},
+		{"at without a time", config{Duration: 120, Increment: 1, Type: "english", Start: "at"}, 0, false},
+		{"unknown start", config{Duration: 120, Increment: 1, Type: "english", Start: "later"}, 0, false},
+		{"sealed", config{Duration: 120, Increment: 1, Type: "sealed", Start: "first-bid", RevealWindow: 30}, auction.StartMode_FIRST_BID, true},
+		{"unknown type", config{Duration: 120, Increment: 1, Type: "reverse", Start: "first-bid"}, 0, false},
+		{"no duration", config{Duration: 0, Increment: 1, Type: "english", Start: "first-bid"}, 0, false},
+		{"no increment", config{Duration: 120, Increment: 0, Type: "english", Start: "first-bid"}, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rules, error := test.config.rules()
+			if (error == nil) != test.valid {
+				t.Fatalf("rules = %v, want valid: %t", error, test.valid)
+			}
+
+			if test.valid && rules.Start != test.start {
+				t.Errorf("start = %s, want %s", rules.Start, test.start)
+			}
+		})
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules *auction.Rules
+		valid bool
+	}{
+		{"english", &auction.Rules{Duration: 120, Increment: 1}, true},
+		{"no duration", &auction.Rules{Duration: 0, Increment: 1}, false},
+		{"no increment", &auction.Rules{Duration: 120, Increment: 0}, false},
+		{"negative extension", &auction.Rules{Duration: 120, Increment: 1, ExtensionWindow: 10, Extension: -5}, false},
+		{"negative extension window", &auction.Rules{Duration: 120, Increment: 1, ExtensionWindow: -10}, false},
+		{"dutch", &auction.Rules{Duration: 120, Increment: 1, Type: auction.AuctionType_DUTCH, Start: auction.StartMode_MANUAL, DropInterval: 1}, true},
+		{"dutch from the first bid", &auction.Rules{Duration: 120, Increment: 1, Type: auction.AuctionType_DUTCH, Start: auction.StartMode_FIRST_BID, DropInterval: 1}, false},
+		{"dutch without a drop interval", &auction.Rules{Duration: 120, Increment: 1, Type: auction.AuctionType_DUTCH, Start: auction.StartMode_AT_TIME}, false},
+		{"sealed", &auction.Rules{Duration: 120, Increment: 1, Type: auction.AuctionType_SEALED, RevealWindow: 30}, true},
+		{"vickrey without a reveal window", &auction.Rules{Duration: 120, Increment: 1, Type: auction.AuctionType_VICKREY}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			error := validateRules(test.rules)
+			if (error == nil) != test.valid {
+				t.Errorf("validateRules = %v, want valid: %t", error, test.valid)
+			}
+		})
+	}
+}
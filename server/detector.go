@@ -0,0 +1,132 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"log"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+)
+
+// A peer is suspected when it has not been heard from within suspectTimeout, which is several missed heartbeats.
+const suspectTimeout = electionTimeout
+
+// detector is a timeout based failure detector. Every server sends a heartbeat to every peer, and a peer is alive
+// as long as its heartbeats or its answers to ours keep arriving.
+type detector struct {
+	LastHeard map[int]time.Time
+	Alive     map[int]bool
+
+	Mutex sync.Mutex
+}
+
+func Detector() *detector {
+	return &detector{
+		LastHeard: make(map[int]time.Time),
+		Alive:     make(map[int]bool),
+	}
+}
+
+func (d *detector) heard(port int) {
+	d.Mutex.Lock()
+	defer d.Mutex.Unlock()
+
+	d.LastHeard[port] = time.Now()
+	if !d.Alive[port] {
+		log.Printf("Server %d is alive", port)
+		d.Alive[port] = true
+	}
+}
+
+// check suspects the peers that have been silent for too long.
+func (d *detector) check() {
+	d.Mutex.Lock()
+	defer d.Mutex.Unlock()
+
+	for port, alive := range d.Alive {
+		if alive && time.Since(d.LastHeard[port]) > suspectTimeout {
+			log.Printf("Server %d is suspected", port)
+			d.Alive[port] = false
+		}
+	}
+}
+
+func (d *detector) alive(port int) bool {
+	d.Mutex.Lock()
+	defer d.Mutex.Unlock()
+
+	return d.Alive[port]
+}
+
+func (s *server) Heartbeat(_ context.Context, message *auction.HeartbeatMessage) (*auction.HeartbeatResponse, error) {
+	s.Detector.heard(int(message.Port))
+
+	return &auction.HeartbeatResponse{Port: int32(s.Port)}, nil
+}
+
+// View returns which peers this server believes are alive.
+func (s *server) View(_ context.Context, request *auction.ViewRequest) (*auction.ViewResponse, error) {
+	s.RaftMutex.Lock()
+	peers := maps.Clone(s.Peers)
+	s.RaftMutex.Unlock()
+
+	s.Detector.Mutex.Lock()
+	defer s.Detector.Mutex.Unlock()
+
+	response := &auction.ViewResponse{Port: int32(s.Port)}
+	for port := range peers {
+		peer := &auction.ViewResponse_PeerMessage{Port: int32(port), Alive: s.Detector.Alive[port]}
+		if lastHeard, ok := s.Detector.LastHeard[port]; ok {
+			peer.LastHeard = lastHeard.UnixMilli()
+		}
+
+		response.Peers = append(response.Peers, peer)
+	}
+
+	sort.Slice(response.Peers, func(i, j int) bool {
+		return response.Peers[i].Port < response.Peers[j].Port
+	})
+
+	return response, nil
+}
+
+// heartbeat sends heartbeats to the peers and suspects the ones that stop answering.
+func (s *server) heartbeat() {
+	for range time.Tick(heartbeatInterval) {
+		s.RaftMutex.Lock()
+		peers := maps.Clone(s.Peers)
+		s.RaftMutex.Unlock()
+
+		for port, peer := range peers {
+			go func(port int, peer auction.FailureDetectorClient) {
+				ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+				defer cancel()
+
+				_, error := peer.Heartbeat(ctx, &auction.HeartbeatMessage{Port: int32(s.Port)})
+				if error == nil {
+					s.Detector.heard(port)
+				}
+			}(port, peer)
+		}
+
+		s.Detector.check()
+	}
+}
+
+// alive returns how many members, this server included, are believed to be alive. RaftMutex must be held.
+func (s *server) alive() int {
+	count := 0
+	if s.member() {
+		count++
+	}
+
+	for port := range s.Peers {
+		if s.Detector.alive(port) {
+			count++
+		}
+	}
+
+	return count
+}
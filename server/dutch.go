@@ -0,0 +1,137 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *server) Accept(ctx context.Context, request *auction.AcceptRequest) (*auction.AcceptResponse, error) {
+	error := s.ready()
+	if error != nil {
+		return &auction.AcceptResponse{}, error
+	}
+
+	bidder, error := s.authenticate(ctx)
+	if error != nil {
+		return &auction.AcceptResponse{}, error
+	}
+
+	request.Id = bidder.Id
+	request.Name = bidder.Name
+
+	if request.RequestId == "" {
+		return &auction.AcceptResponse{}, status.Errorf(codes.InvalidArgument, "the acceptance has no request id")
+	}
+
+	// A retried acceptance gets the outcome it got the first time.
+	key := requestKey{BidderId: request.Id, RequestId: request.RequestId}
+	outcome, ok := s.outcome(request.AuctionId, key)
+	if ok {
+		return s.accepted(request, outcome)
+	}
+
+	// Acceptances that fail are committed too, so every server has them in the history of the auction.
+	s.BidMutex.Lock()
+	_, ok = s.Auctions[request.AuctionId]
+	s.BidMutex.Unlock()
+	if !ok {
+		return &auction.AcceptResponse{}, bidError(auction.Reason_AUCTION_NOT_FOUND, 0, "auction %d does not exist", request.AuctionId)
+	}
+
+	_, error = s.commit(ctx, &auction.LogEntry{Event: &auction.LogEntry_Accept{Accept: request}})
+
+	outcome, ok = s.outcome(request.AuctionId, key)
+	if ok {
+		return s.accepted(request, outcome)
+	}
+
+	return &auction.AcceptResponse{}, error
+}
+
+// accepted returns the price the acceptance won the auction at, or why it failed.
+func (s *server) accepted(request *auction.AcceptRequest, outcome outcome) (*auction.AcceptResponse, error) {
+	_, error := outcome.result()
+	if error != nil {
+		return &auction.AcceptResponse{}, error
+	}
+
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	return &auction.AcceptResponse{Price: int64(s.Auctions[request.AuctionId].Price)}, nil
+}
+
+// ask returns the price of a dutch auction at the given time. It drops by the increment every drop interval, but never below the reserve price.
+func (l *lot) ask(at time.Time) int64 {
+	if !l.Started {
+		return l.Rules.StartingBid
+	}
+
+	drops := int64(at.Sub(l.StartedAt) / (time.Duration(l.Rules.DropInterval) * time.Second))
+
+	return max(l.Rules.StartingBid-drops*l.Rules.Increment, l.Rules.Reserve)
+}
+
+// accept ends a dutch auction at the price asked when the leader received the acceptance.
+// The acceptance is kept in the history as a bid of that price.
+func (s *server) accept(request *auction.AcceptRequest, at time.Time) error {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	bid := &auction.BidRequest{Id: request.Id, Name: request.Name, AuctionId: request.AuctionId, RequestId: request.RequestId}
+	lot, ok := s.Auctions[request.AuctionId]
+	if ok && lot.Rules.Type == auction.AuctionType_DUTCH {
+		bid.Amount = lot.ask(at)
+	}
+
+	return s.once(bid, at, func() error {
+		return s.acceptPrice(request, at)
+	})
+}
+
+// acceptPrice sets the winner of the dutch auction. BidMutex must be held.
+func (s *server) acceptPrice(request *auction.AcceptRequest, at time.Time) error {
+	error := s.validateAccept(request, at)
+	if error != nil {
+		return error
+	}
+
+	lot := s.Auctions[request.AuctionId]
+	lot.HighestBidderId = int(request.Id)
+	lot.HighestBidderName = request.Name
+	lot.HighestBid = int(lot.ask(at))
+	lot.Price = lot.HighestBid
+
+	log.Printf("Auction %d accepted at %d by %s", lot.Id, lot.HighestBid, lot.HighestBidderName)
+	lot.Finished = true
+	lot.chain(at)
+	lot.finish()
+
+	return nil
+}
+
+func (s *server) validateAccept(request *auction.AcceptRequest, at time.Time) error {
+	lot, ok := s.Auctions[request.AuctionId]
+	if !ok {
+		return bidError(auction.Reason_AUCTION_NOT_FOUND, 0, "auction %d does not exist", request.AuctionId)
+	}
+
+	if lot.Rules.Type != auction.AuctionType_DUTCH {
+		return bidError(auction.Reason_NOT_BIDDABLE, lot.highestBid(), "auction %d is not a dutch auction", request.AuctionId)
+	}
+
+	if lot.Finished || (lot.Started && !at.Before(lot.Deadline)) {
+		return bidError(auction.Reason_AUCTION_DONE, lot.highestBid(), "auction is done")
+	}
+
+	if !lot.Started {
+		return bidError(auction.Reason_NOT_STARTED, lot.highestBid(), "auction has not started yet")
+	}
+
+	return nil
+}
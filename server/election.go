@@ -0,0 +1,216 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"log"
+	"maps"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	heartbeatInterval = 200 * time.Millisecond
+	electionTimeout   = time.Second
+	rpcTimeout        = 200 * time.Millisecond
+)
+
+func (s *server) leader() int {
+	s.RaftMutex.Lock()
+	defer s.RaftMutex.Unlock()
+
+	return s.Leader
+}
+
+func (s *server) majority() int {
+	return len(s.Membership)/2 + 1
+}
+
+func (s *server) lastIndex() int64 {
+	return s.LogStart + int64(len(s.Log)-1)
+}
+
+// entry returns the entry at the index. Entries up to LogStart have been replaced by a snapshot, and only the term of LogStart is kept.
+func (s *server) entry(index int64) *auction.LogEntry {
+	return s.Log[index-s.LogStart]
+}
+
+func (s *server) lastTerm() int64 {
+	return s.Log[len(s.Log)-1].Term
+}
+
+func (s *server) Election(_ context.Context, message *auction.ElectionMessage) (*auction.VoteResponse, error) {
+	s.RaftMutex.Lock()
+	defer s.RaftMutex.Unlock()
+
+	// A server that still hears from the leader ignores candidates, so a server that was removed from the cluster can not disrupt it.
+	if s.Role == leader || (s.Leader != 0 && time.Since(s.LastHeard) < electionTimeout) {
+		return &auction.VoteResponse{Term: s.Term, Granted: false}, nil
+	}
+
+	if message.Term > s.Term {
+		s.follow(message.Term)
+	}
+
+	// A candidate only gets the vote if its log is at least as up-to-date as ours, so a new leader always has every committed bid.
+	upToDate := message.LastLogTerm > s.lastTerm() || (message.LastLogTerm == s.lastTerm() && message.LastLogIndex >= s.lastIndex())
+
+	granted := false
+	if message.Term == s.Term && (s.VotedFor == 0 || s.VotedFor == int(message.Port)) && upToDate {
+		s.VotedFor = int(message.Port)
+		s.LastHeard = time.Now()
+		s.persist(0, nil)
+		granted = true
+	}
+
+	return &auction.VoteResponse{Term: s.Term, Granted: granted}, nil
+}
+
+// follow makes the server a follower, moving it to the given term if it is newer. RaftMutex must be held.
+func (s *server) follow(term int64) {
+	if term > s.Term {
+		s.Term = term
+		s.VotedFor = 0
+		s.persist(0, nil)
+	}
+
+	if s.Role == leader {
+		log.Printf("Stepping down as leader in term %d", s.Term)
+
+		for index, waiting := range s.Waiting {
+			waiting <- status.Errorf(codes.Unavailable, "server %d lost the leadership before the entry was committed", s.Port)
+			delete(s.Waiting, index)
+		}
+	}
+
+	s.Role = follower
+}
+
+func (s *server) election() {
+	s.RaftMutex.Lock()
+
+	// A server that can not reach a majority could not win, and would only disturb the others with a higher term.
+	if s.alive() < s.majority() {
+		log.Printf("Not starting an election - only %d of %d servers are alive", s.alive(), len(s.Membership))
+		s.LastHeard = time.Now()
+		s.RaftMutex.Unlock()
+		return
+	}
+
+	s.Term++
+	s.Role = candidate
+	s.Leader = 0
+	s.VotedFor = s.Port
+	s.LastHeard = time.Now()
+	s.persist(0, nil)
+
+	term := s.Term
+	peers := maps.Clone(s.Peers)
+	message := &auction.ElectionMessage{
+		Port:         int32(s.Port),
+		Term:         s.Term,
+		LastLogIndex: s.lastIndex(),
+		LastLogTerm:  s.lastTerm(),
+	}
+	s.RaftMutex.Unlock()
+
+	log.Printf("Starting election for term %d", term)
+
+	votes := 1
+	for _, peer := range peers {
+		go func(peer auction.ElectionClient) {
+			ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+			defer cancel()
+
+			response, error := peer.Election(ctx, message)
+			if error != nil {
+				return
+			}
+
+			s.RaftMutex.Lock()
+			defer s.RaftMutex.Unlock()
+
+			if response.Term > s.Term {
+				s.follow(response.Term)
+				return
+			}
+
+			if !response.Granted || s.Role != candidate || s.Term != term {
+				return
+			}
+
+			votes++
+			if votes >= s.majority() {
+				s.lead()
+			}
+		}(peer)
+	}
+}
+
+// lead makes the server the leader of the current term. RaftMutex must be held.
+func (s *server) lead() {
+	log.Printf("Elected as leader for term %d", s.Term)
+
+	s.Role = leader
+	s.Leader = s.Port
+	s.Ready = true
+
+	for port := range s.Peers {
+		s.NextIndex[port] = s.lastIndex() + 1
+		s.MatchIndex[port] = 0
+	}
+
+	// A leader only commits entries of its own term, so a no-op commits the entries it got from earlier leaders.
+	s.append(&auction.LogEntry{Term: s.Term, Timestamp: time.Now().UnixMilli()})
+
+	go s.broadcast()
+
+	// The default auction is created through the log like any other, so every server has it with the same rules.
+	initial := &auction.LogEntry{Event: &auction.LogEntry_Create{Create: &auction.LogEntry_CreateMessage{Name: "Default", Rules: s.Rules, Initial: true}}}
+	go s.propose(context.Background(), initial, s.hasDefault)
+}
+
+// hasDefault returns whether the default auction has been created.
+func (s *server) hasDefault() bool {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	_, ok := s.Auctions[0]
+	return ok
+}
+
+func (s *server) monitor() {
+	timeout := randomTimeout()
+
+	for range time.Tick(heartbeatInterval) {
+		s.RaftMutex.Lock()
+		role := s.Role
+		lastHeard := s.LastHeard
+		member := s.member()
+
+		// A leader that can not reach a majority can not commit anything, and a new leader is probably elected by the others.
+		if role == leader && s.alive() < s.majority() {
+			log.Printf("Only %d of %d servers are alive", s.alive(), len(s.Membership))
+			s.follow(s.Term)
+			s.Leader = 0
+			role = follower
+		}
+		s.RaftMutex.Unlock()
+
+		// A server that is not a member waits for the leader to add it.
+		if role == leader {
+			s.broadcast()
+		} else if member && time.Since(lastHeard) > timeout {
+			go s.election()
+			timeout = randomTimeout()
+		}
+	}
+}
+
+// randomTimeout spreads the election timeouts out so the servers rarely start elections at the same time.
+func randomTimeout() time.Duration {
+	return electionTimeout + time.Duration(rand.Int63n(int64(electionTimeout)))
+}
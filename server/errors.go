@@ -0,0 +1,40 @@
+package main
+
+import (
+	"auction/auction"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reasonCodes are the status codes of the reasons a bid fails. A bid that breaks the rules is an invalid argument,
+// and a bid that does not fit the current state of the auction fails its precondition.
+var reasonCodes = map[auction.Reason]codes.Code{
+	auction.Reason_AUCTION_NOT_FOUND:  codes.NotFound,
+	auction.Reason_AUCTION_DONE:       codes.FailedPrecondition,
+	auction.Reason_NOT_STARTED:        codes.FailedPrecondition,
+	auction.Reason_NOT_BIDDABLE:       codes.FailedPrecondition,
+	auction.Reason_ALREADY_BID:        codes.AlreadyExists,
+	auction.Reason_BELOW_STARTING_BID: codes.InvalidArgument,
+	auction.Reason_MAXIMUM_BELOW_BID:  codes.InvalidArgument,
+	auction.Reason_OWN_BID:            codes.FailedPrecondition,
+	auction.Reason_TOO_LOW:            codes.FailedPrecondition,
+	auction.Reason_OUTBID:             codes.Aborted,
+	auction.Reason_INVALID_COMMITMENT: codes.InvalidArgument,
+	auction.Reason_NOT_REVEALING:      codes.FailedPrecondition,
+	auction.Reason_NO_COMMITMENT:      codes.FailedPrecondition,
+}
+
+// bidError rejects a bid. The details of the status hold a BidResponse with the reason and the highest bid,
+// so clients do not have to parse the message.
+func bidError(reason auction.Reason, highestBid int64, format string, a ...any) error {
+	rejected := status.New(reasonCodes[reason], fmt.Sprintf(format, a...))
+
+	detailed, error := rejected.WithDetails(&auction.BidResponse{Outcome: auction.Outcome_FAIL, Reason: reason, HighestBid: highestBid})
+	if error != nil {
+		return rejected.Err()
+	}
+
+	return detailed.Err()
+}
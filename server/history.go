@@ -0,0 +1,76 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// historyLimit is the most records a page of the history holds.
+const historyLimit = 100
+
+// remember adds the bid and its outcome to the history of the lot. A bid that only raised the maximum of the leading bidder
+// is kept without its amount, since the highest bid stayed the same. BidMutex must be held.
+func (l *lot) remember(bid *auction.BidRequest, at time.Time, recorded outcome, raisedMaximum bool) {
+	record := &auction.BidRecord{
+		Timestamp: at.UnixMilli(),
+		BidderId:  bid.Id,
+		Name:      bid.Name,
+		Amount:    bid.Amount,
+		Outcome:   recorded.Response.Outcome,
+		Reason:    recorded.Response.Reason,
+		Message:   recorded.Message,
+	}
+
+	if raisedMaximum {
+		record.Amount = 0
+		record.RaisedMaximum = true
+	}
+
+	l.History = append(l.History, record)
+}
+
+// BidHistory returns a page of every bid in the auction, oldest first. Every server applies the same bids,
+// so they all have the same history. The amounts of sealed bids stay hidden until the auction is over.
+func (s *server) BidHistory(_ context.Context, request *auction.BidHistoryRequest) (*auction.BidHistoryResponse, error) {
+	error := s.ready()
+	if error != nil {
+		return &auction.BidHistoryResponse{}, error
+	}
+
+	if request.Offset < 0 || request.Limit < 0 {
+		return &auction.BidHistoryResponse{}, status.Errorf(codes.InvalidArgument, "the offset and the limit can not be negative")
+	}
+
+	limit := int64(request.Limit)
+	if limit == 0 || limit > historyLimit {
+		limit = historyLimit
+	}
+
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	lot, ok := s.Auctions[request.AuctionId]
+	if !ok {
+		return &auction.BidHistoryResponse{}, missing(request.AuctionId)
+	}
+
+	total := int64(len(lot.History))
+	start := min(request.Offset, total)
+	end := min(start+limit, total)
+
+	response := &auction.BidHistoryResponse{Total: total}
+	for _, record := range lot.History[start:end] {
+		if lot.sealed() && !lot.Finished {
+			// The messages of sealed bids may mention the amount too.
+			record = &auction.BidRecord{Timestamp: record.Timestamp, BidderId: record.BidderId, Name: record.Name, Outcome: record.Outcome, Reason: record.Reason, RaisedMaximum: record.RaisedMaximum}
+		}
+
+		response.Records = append(response.Records, record)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"testing"
+	"time"
+)
+
+func TestHistory(t *testing.T) {
+	s := testServer(testRules())
+	s.Ready = true
+
+	bids := []*auction.BidRequest{
+		{Id: 2, Name: "second", Amount: 90, RequestId: "a"},
+		{Id: 2, Name: "second", Amount: 90, RequestId: "a"},
+		{Id: 3, Name: "third", Amount: 91, RequestId: "b"},
+		{Id: 2, Name: "second", Amount: 95, Maximum: 120, RequestId: "c"},
+		{Id: 3, Name: "third", Amount: 100, RequestId: "d"},
+	}
+	for _, bid := range bids {
+		s.auction(bid, time.Now())
+	}
+
+	tests := []struct {
+		name    string
+		offset  int64
+		limit   int32
+		records []*auction.BidRecord
+	}{
+		{"every record", 0, 0, []*auction.BidRecord{
+			{BidderId: 2, Amount: 90, Outcome: auction.Outcome_SUCCESS},
+			{BidderId: 3, Amount: 91, Outcome: auction.Outcome_FAIL, Reason: auction.Reason_TOO_LOW},
+			{BidderId: 2, RaisedMaximum: true, Outcome: auction.Outcome_SUCCESS},
+			{BidderId: 3, Amount: 100, Outcome: auction.Outcome_FAIL, Reason: auction.Reason_OUTBID},
+		}},
+		{"a page", 1, 2, []*auction.BidRecord{
+			{BidderId: 3, Amount: 91, Outcome: auction.Outcome_FAIL, Reason: auction.Reason_TOO_LOW},
+			{BidderId: 2, RaisedMaximum: true, Outcome: auction.Outcome_SUCCESS},
+		}},
+		{"past the end", 10, 2, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, error := s.BidHistory(context.Background(), &auction.BidHistoryRequest{Offset: test.offset, Limit: test.limit})
+			if error != nil {
+				t.Fatalf("BidHistory failed: %s", error)
+			}
+
+			if response.Total != 4 || len(response.Records) != len(test.records) {
+				t.Fatalf("BidHistory returned %d of %d records, want %d of 4", len(response.Records), response.Total, len(test.records))
+			}
+
+			for i, record := range response.Records {
+				want := test.records[i]
+				if record.BidderId != want.BidderId || record.Amount != want.Amount || record.RaisedMaximum != want.RaisedMaximum ||
+					record.Outcome != want.Outcome || record.Reason != want.Reason {
+					t.Errorf("record %d = bidder %d %d raised %t %s %s, want bidder %d %d raised %t %s %s", i,
+						record.BidderId, record.Amount, record.RaisedMaximum, record.Outcome, record.Reason,
+						want.BidderId, want.Amount, want.RaisedMaximum, want.Outcome, want.Reason)
+				}
+			}
+		})
+	}
+}
+
+func TestSealedHistory(t *testing.T) {
+	rules := testRules()
+	rules.Type = auction.AuctionType_SEALED
+	s := testServer(rules)
+	s.Ready = true
+
+	lot := s.Auctions[0]
+	lot.HighestBidderId = 0
+	lot.HighestBid = 0
+	lot.HighestMaximum = 0
+
+	s.auction(&auction.BidRequest{Id: 2, Name: "second", Amount: 70, RequestId: "a"}, time.Now())
+
+	response, error := s.BidHistory(context.Background(), &auction.BidHistoryRequest{})
+	if error != nil || len(response.Records) != 1 {
+		t.Fatalf("BidHistory = %v, %v, want one record", response, error)
+	}
+
+	if response.Records[0].Amount != 0 {
+		t.Errorf("the amount of a sealed bid is %d before the auction is over, want it hidden", response.Records[0].Amount)
+	}
+
+	lot.Finished = true
+
+	response, _ = s.BidHistory(context.Background(), &auction.BidHistoryRequest{})
+	if response.Records[0].Amount != 70 {
+		t.Errorf("the amount of a sealed bid is %d after the auction, want 70", response.Records[0].Amount)
+	}
+}
+
+func TestAcceptHistory(t *testing.T) {
+	rules := testRules()
+	rules.Type = auction.AuctionType_DUTCH
+	rules.StartingBid = 100
+	rules.Reserve = 50
+	rules.DropInterval = 10
+	s := testServer(rules)
+	s.Ready = true
+
+	lot := s.Auctions[0]
+	lot.HighestBidderId = 0
+	lot.HighestBid = 0
+	lot.StartedAt = time.Now()
+
+	s.accept(&auction.AcceptRequest{Id: 2, Name: "second", RequestId: "a"}, time.Now())
+	s.accept(&auction.AcceptRequest{Id: 3, Name: "third", RequestId: "a"}, time.Now())
+
+	response, _ := s.BidHistory(context.Background(), &auction.BidHistoryRequest{})
+	if len(response.Records) != 2 {
+		t.Fatalf("BidHistory returned %d records, want 2", len(response.Records))
+	}
+
+	accepted, late := response.Records[0], response.Records[1]
+	if accepted.BidderId != 2 || accepted.Amount != 100 || accepted.Outcome != auction.Outcome_SUCCESS {
+		t.Errorf("first acceptance = bidder %d %d %s, want bidder 2 100 SUCCESS", accepted.BidderId, accepted.Amount, accepted.Outcome)
+	}
+
+	if late.BidderId != 3 || late.Outcome != auction.Outcome_FAIL || late.Reason != auction.Reason_AUCTION_DONE {
+		t.Errorf("second acceptance = bidder %d %s %s, want bidder 3 FAIL AUCTION_DONE", late.BidderId, late.Outcome, late.Reason)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chain adds the state of the lot to the ledger if it changed since the newest entry. It is called after every change
+// of the highest bid, including the raises of proxy bids, so the newest entry is always the current state.
+// Every server applies the same bids in the same order, so every server has the same ledger and the same head. BidMutex must be held.
+func (l *lot) chain(at time.Time) {
+	record := &auction.LedgerRecord{
+		BidderId:   int32(l.HighestBidderId),
+		Name:       l.HighestBidderName,
+		HighestBid: int64(l.HighestBid),
+		Price:      int64(l.Price),
+		Finished:   l.Finished,
+	}
+
+	if len(l.Ledger) > 0 {
+		newest := l.Ledger[len(l.Ledger)-1].Record
+		if newest.BidderId == record.BidderId && newest.Name == record.Name && newest.HighestBid == record.HighestBid &&
+			newest.Price == record.Price && newest.Finished == record.Finished {
+			return
+		}
+	} else if record.BidderId == 0 && !record.Finished {
+		// Nobody has bid yet.
+		return
+	}
+
+	record.Timestamp = at.UnixMilli()
+	previous := l.head()
+	l.Ledger = append(l.Ledger, &auction.LedgerEntry{Record: record, Previous: previous, Hash: auction.Chain(previous, record)})
+}
+
+// head returns the hash of the newest entry of the ledger. BidMutex must be held.
+func (l *lot) head() []byte {
+	if len(l.Ledger) == 0 {
+		return nil
+	}
+
+	return l.Ledger[len(l.Ledger)-1].Hash
+}
+
+// visibleHead returns the head that may be shown. The amounts of sealed bids could be guessed from it,
+// so it stays hidden until the auction is over. BidMutex must be held.
+func (l *lot) visibleHead() []byte {
+	if l.sealed() && !l.Finished {
+		return nil
+	}
+
+	return l.head()
+}
+
+// Ledger returns the changes of the highest bid of the auction with their hashes, so they can be checked against the head.
+func (s *server) Ledger(_ context.Context, request *auction.LedgerRequest) (*auction.LedgerResponse, error) {
+	error := s.ready()
+	if error != nil {
+		return &auction.LedgerResponse{}, error
+	}
+
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	lot, ok := s.Auctions[request.AuctionId]
+	if !ok {
+		return &auction.LedgerResponse{}, missing(request.AuctionId)
+	}
+
+	if lot.sealed() && !lot.Finished {
+		return &auction.LedgerResponse{}, status.Errorf(codes.FailedPrecondition, "the ledger of a sealed auction is hidden until the auction is over")
+	}
+
+	return &auction.LedgerResponse{Entries: lot.Ledger, Head: lot.head()}, nil
+}
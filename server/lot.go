@@ -0,0 +1,361 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// commitment is the hash a bidder committed to a sealed bid with, and the index of the log entry it was committed in.
+type commitment struct {
+	Hash  []byte
+	Index int64
+}
+
+type lot struct {
+	Id    int32
+	Name  string
+	Rules *auction.Rules
+
+	HighestBidderId   int
+	HighestBidderName string
+	HighestBid        int
+	HighestMaximum    int
+	Price             int
+
+	// Commitments are the hashes sealed bids are committed with by bidder id, and SealedBids the bids revealed after the bidding.
+	Commitments map[int32]commitment
+	SealedBids  []*auction.BidRequest
+	Revealing   bool
+
+	// Outcomes are the results of the requests applied from the log by their bidders and request ids.
+	Outcomes       map[requestKey]outcome
+	OutcomeWaiters map[requestKey][]chan outcome
+
+	// History is every bid applied from the log, whether it was placed or not.
+	History []*auction.BidRecord
+	Ledger  []*auction.LedgerEntry
+
+	StartedAt time.Time
+	Deadline  time.Time
+	Extended  int64
+
+	Started  bool
+	Finished bool
+
+	Context context.Context
+	Cancel  context.CancelFunc
+
+	Watchers map[chan *auction.ResultResponse]bool
+}
+
+func Lot(id int32, name string, rules *auction.Rules) *lot {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &lot{
+		Id:    id,
+		Name:  name,
+		Rules: rules,
+
+		HighestBid: int(rules.StartingBid),
+
+		Started:  false,
+		Finished: false,
+
+		Context: ctx,
+		Cancel:  cancel,
+
+		Commitments: make(map[int32]commitment),
+
+		Outcomes:       make(map[requestKey]outcome),
+		OutcomeWaiters: make(map[requestKey][]chan outcome),
+
+		Watchers: make(map[chan *auction.ResultResponse]bool),
+	}
+}
+
+func (l *lot) sealed() bool {
+	return l.Rules.Type == auction.AuctionType_SEALED || l.Rules.Type == auction.AuctionType_VICKREY
+}
+
+// highestBid returns the highest bid that may be shown to the bidders. Sealed bids stay hidden until the auction is over.
+func (l *lot) highestBid() int64 {
+	if (l.sealed() || l.Rules.Type == auction.AuctionType_DUTCH) && !l.Finished {
+		return 0
+	}
+
+	return int64(l.HighestBid)
+}
+
+// settle decides the winner of a sealed auction and the price the winner pays. Only the bids that were revealed count. BidMutex must be held.
+func (l *lot) settle() {
+	l.Price = l.HighestBid
+	if !l.sealed() || len(l.SealedBids) == 0 {
+		return
+	}
+
+	// Among equal bids the one committed first wins, whatever order they were revealed in.
+	bids := append([]*auction.BidRequest(nil), l.SealedBids...)
+	sort.Slice(bids, func(i, j int) bool {
+		if bids[i].Amount != bids[j].Amount {
+			return bids[i].Amount > bids[j].Amount
+		}
+
+		return l.Commitments[bids[i].Id].Index < l.Commitments[bids[j].Id].Index
+	})
+
+	winner := bids[0]
+	l.HighestBidderId = int(winner.Id)
+	l.HighestBidderName = winner.Name
+	l.HighestBid = int(winner.Amount)
+	l.Price = l.HighestBid
+
+	if l.Rules.Type == auction.AuctionType_VICKREY {
+		secondBid := l.Rules.StartingBid
+		if len(bids) > 1 {
+			secondBid = bids[1].Amount
+		}
+
+		l.Price = int(max(secondBid, l.Rules.Reserve))
+	}
+}
+
+// remaining returns the number of seconds left of the auction, rounded up.
+func (l *lot) remaining() int64 {
+	if !l.Started {
+		return l.Rules.Duration
+	}
+
+	left := time.Until(l.Deadline)
+	if l.Finished || left <= 0 {
+		return 0
+	}
+
+	return int64((left + time.Second - 1) / time.Second)
+}
+
+func (l *lot) result() *auction.ResultResponse {
+	var ask int64
+	if l.Rules.Type == auction.AuctionType_DUTCH && !l.Finished {
+		ask = l.ask(time.Now())
+	}
+
+	if l.Finished {
+		// Below the reserve price nobody wins.
+		winner := l.HighestBidderName
+		if int64(l.HighestBid) < l.Rules.Reserve {
+			winner = ""
+		}
+
+		return &auction.ResultResponse{
+			Event: &auction.ResultResponse_Winner{
+				Winner: &auction.ResultResponse_WinnerMessage{
+					Name:   winner,
+					Amount: int64(l.HighestBid),
+					Price:  int64(l.Price),
+				},
+			},
+			Rules:      l.Rules,
+			LedgerHead: l.visibleHead(),
+		}
+	} else {
+		return &auction.ResultResponse{
+			Event: &auction.ResultResponse_Status{
+				Status: &auction.ResultResponse_StatusMessage{
+					Time:       l.remaining(),
+					HighestBid: l.highestBid(),
+					Started:    l.Started,
+					Extended:   l.Extended,
+					Ask:        ask,
+					Revealing:  l.Revealing,
+				},
+			},
+			Rules:      l.Rules,
+			LedgerHead: l.visibleHead(),
+		}
+	}
+}
+
+func (s *server) CreateAuction(ctx context.Context, request *auction.CreateAuctionRequest) (*auction.CreateAuctionResponse, error) {
+	// The rules go into the log, so every server uses the same rules even if they were started with different ones.
+	rules := request.Rules
+	if rules == nil {
+		rules = s.Rules
+	}
+
+	error := validateRules(rules)
+	if error != nil {
+		return &auction.CreateAuctionResponse{}, error
+	}
+
+	index, error := s.commit(ctx, &auction.LogEntry{Event: &auction.LogEntry_Create{Create: &auction.LogEntry_CreateMessage{Name: request.Name, Rules: rules}}})
+	if error != nil {
+		return &auction.CreateAuctionResponse{}, error
+	}
+
+	return &auction.CreateAuctionResponse{AuctionId: int32(index)}, nil
+}
+
+func (s *server) ListAuctions(_ context.Context, request *auction.ListAuctionsRequest) (*auction.ListAuctionsResponse, error) {
+	error := s.ready()
+	if error != nil {
+		return &auction.ListAuctionsResponse{}, error
+	}
+
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	response := &auction.ListAuctionsResponse{}
+	for _, lot := range s.Auctions {
+		response.Auctions = append(response.Auctions, &auction.ListAuctionsResponse_AuctionMessage{
+			AuctionId:  lot.Id,
+			Name:       lot.Name,
+			HighestBid: lot.highestBid(),
+			Time:       lot.remaining(),
+			Started:    lot.Started,
+			Finished:   lot.Finished,
+		})
+	}
+
+	sort.Slice(response.Auctions, func(i, j int) bool {
+		return response.Auctions[i].AuctionId < response.Auctions[j].AuctionId
+	})
+
+	return response, nil
+}
+
+func (s *server) StartAuction(ctx context.Context, request *auction.StartAuctionRequest) (*auction.StartAuctionResponse, error) {
+	s.BidMutex.Lock()
+	lot, ok := s.Auctions[request.AuctionId]
+	if !ok {
+		s.BidMutex.Unlock()
+		return &auction.StartAuctionResponse{}, missing(request.AuctionId)
+	}
+
+	if lot.Rules.Start != auction.StartMode_MANUAL {
+		s.BidMutex.Unlock()
+		return &auction.StartAuctionResponse{}, status.Errorf(codes.FailedPrecondition, "auction %d is not started manually", request.AuctionId)
+	}
+	s.BidMutex.Unlock()
+
+	_, error := s.commit(ctx, &auction.LogEntry{Event: &auction.LogEntry_Start{Start: &auction.LogEntry_StartMessage{AuctionId: request.AuctionId}}})
+	if error != nil {
+		return &auction.StartAuctionResponse{}, error
+	}
+
+	return &auction.StartAuctionResponse{}, nil
+}
+
+func (s *server) CloseAuction(ctx context.Context, request *auction.CloseAuctionRequest) (*auction.CloseAuctionResponse, error) {
+	// Only an auction that exists is closed through the log.
+	s.BidMutex.Lock()
+	_, ok := s.Auctions[request.AuctionId]
+	s.BidMutex.Unlock()
+	if !ok {
+		return &auction.CloseAuctionResponse{}, missing(request.AuctionId)
+	}
+
+	_, error := s.commit(ctx, &auction.LogEntry{Event: &auction.LogEntry_Close{Close: &auction.LogEntry_CloseMessage{AuctionId: request.AuctionId, Expired: false}}})
+	if error != nil {
+		return &auction.CloseAuctionResponse{}, error
+	}
+
+	return &auction.CloseAuctionResponse{}, nil
+}
+
+// create adds a lot. Its id is the index of the log entry that created it, so it is the same on every server.
+func (s *server) create(id int32, name string, rules *auction.Rules) {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	// Every new leader proposes the default auction until it exists, so it may be in the log more than once.
+	_, exists := s.Auctions[id]
+	if exists {
+		return
+	}
+
+	log.Printf("Auction %d created: %s", id, name)
+	lot := Lot(id, name, rules)
+	s.Auctions[id] = lot
+
+	go s.schedule(lot.Context, id)
+}
+
+func (s *server) start(id int32, at time.Time) error {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	lot, ok := s.Auctions[id]
+	if !ok {
+		return missing(id)
+	}
+
+	if lot.Started || lot.Finished {
+		return nil
+	}
+
+	s.begin(lot, at)
+	lot.publish()
+
+	return nil
+}
+
+// finish ends the auction, stops its timers and tells the watchers the result. BidMutex must be held.
+func (l *lot) finish() {
+	l.Finished = true
+	l.Cancel()
+	l.publish()
+
+	for watcher := range l.Watchers {
+		close(watcher)
+		delete(l.Watchers, watcher)
+	}
+}
+
+// begin starts the countdown of the lot. BidMutex must be held.
+func (s *server) begin(lot *lot, at time.Time) {
+	lot.Started = true
+	lot.StartedAt = at
+	lot.Deadline = at.Add(time.Duration(lot.Rules.Duration) * time.Second)
+
+	go s.timer(lot.Context, lot.Id)
+}
+
+func (s *server) close(id int32, at time.Time, expired bool) error {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	lot, ok := s.Auctions[id]
+	if !ok {
+		return missing(id)
+	}
+
+	// A late bid before this entry in the log may have extended the deadline after the leader decided to close.
+	if lot.Finished || (expired && at.Before(lot.Deadline)) {
+		return nil
+	}
+
+	// A sealed auction takes the reveals of the bids for the reveal window after the bidding, and is decided after that.
+	if lot.sealed() && lot.Started && !lot.Revealing {
+		log.Printf("Auction %d is revealing", id)
+		lot.Revealing = true
+		lot.Deadline = at.Add(time.Duration(lot.Rules.RevealWindow) * time.Second)
+		lot.publish()
+		return nil
+	}
+
+	log.Printf("Auction %d finished", id)
+	lot.settle()
+
+	// The final state is chained before it is published, so the watchers get the final head.
+	lot.Finished = true
+	lot.chain(at)
+	lot.finish()
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"auction/auction"
+	"testing"
+)
+
+func TestSettle(t *testing.T) {
+	type sealedBid struct {
+		bidder int32
+		amount int64
+		index  int64
+	}
+
+	tests := []struct {
+		name       string
+		kind       auction.AuctionType
+		reserve    int64
+		bids       []sealedBid
+		winner     int
+		highestBid int
+		price      int
+	}{
+		{"first price", auction.AuctionType_SEALED, 0, []sealedBid{{1, 80, 3}, {2, 100, 4}}, 2, 100, 100},
+		{"second price", auction.AuctionType_VICKREY, 0, []sealedBid{{1, 80, 3}, {2, 100, 4}, {3, 90, 5}}, 2, 100, 90},
+		{"second price of a single bid is the starting bid", auction.AuctionType_VICKREY, 0, []sealedBid{{1, 80, 3}}, 1, 80, 50},
+		{"second price below the reserve", auction.AuctionType_VICKREY, 85, []sealedBid{{1, 80, 3}, {2, 100, 4}}, 2, 100, 85},
+		{"second price above the reserve", auction.AuctionType_VICKREY, 70, []sealedBid{{1, 80, 3}, {2, 100, 4}}, 2, 100, 80},
+		{"tie goes to the first commitment", auction.AuctionType_VICKREY, 0, []sealedBid{{1, 100, 6}, {2, 100, 4}}, 2, 100, 100},
+		{"no bids", auction.AuctionType_VICKREY, 0, nil, 0, 50, 50},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lot := Lot(0, "Test", &auction.Rules{StartingBid: 50, Reserve: test.reserve, Type: test.kind})
+			for _, bid := range test.bids {
+				lot.Commitments[bid.bidder] = commitment{Index: bid.index}
+				lot.SealedBids = append(lot.SealedBids, &auction.BidRequest{Id: bid.bidder, Amount: bid.amount})
+			}
+
+			lot.settle()
+
+			if lot.HighestBidderId != test.winner || lot.HighestBid != test.highestBid || lot.Price != test.price {
+				t.Errorf("bidder %d won with %d for %d, want bidder %d with %d for %d",
+					lot.HighestBidderId, lot.HighestBid, lot.Price, test.winner, test.highestBid, test.price)
+			}
+		})
+	}
+}
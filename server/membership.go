@@ -0,0 +1,231 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+)
+
+var members = flag.String("members", "localhost:5000,localhost:5001,localhost:5002", "The addresses of the servers in the cluster, separated by commas")
+var membersFile = flag.String("members-file", "", "A file with the address of a server in the cluster on each line. It is used instead of -members")
+
+// loadMembers returns the addresses the cluster starts with. Once the log or a snapshot has a membership change, that is used instead.
+func loadMembers() ([]string, error) {
+	list := strings.Split(*members, ",")
+	if *membersFile != "" {
+		data, error := os.ReadFile(*membersFile)
+		if error != nil {
+			return nil, error
+		}
+
+		list = strings.Split(string(data), "\n")
+	}
+
+	var addresses []string
+	ports := make(map[int]string)
+	for _, address := range list {
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
+
+		port, error := portOf(address)
+		if error != nil {
+			return nil, error
+		}
+
+		// Even on different hosts two members with the same port could not tell each other apart.
+		if other, ok := ports[port]; ok {
+			return nil, fmt.Errorf("%s and %s have the same port, but every member needs its own", other, address)
+		}
+		ports[port] = address
+
+		addresses = append(addresses, address)
+	}
+
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("the cluster needs at least one member")
+	}
+
+	return addresses, nil
+}
+
+// portOf returns the port of the address. The servers know each other by their ports, so no two members may share one.
+func portOf(address string) (int, error) {
+	_, port, error := net.SplitHostPort(address)
+	if error != nil {
+		return 0, fmt.Errorf("invalid address %q: %s", address, error)
+	}
+
+	return strconv.Atoi(port)
+}
+
+func (s *server) Members(_ context.Context, request *auction.MembersRequest) (*auction.MembersResponse, error) {
+	s.RaftMutex.Lock()
+	defer s.RaftMutex.Unlock()
+
+	return &auction.MembersResponse{Members: s.Membership, Leader: int32(s.Leader)}, nil
+}
+
+func (s *server) AddPeer(ctx context.Context, request *auction.AddPeerRequest) (*auction.AddPeerResponse, error) {
+	error := s.change(ctx, request.Address, true)
+	if error != nil {
+		return &auction.AddPeerResponse{}, error
+	}
+
+	return &auction.AddPeerResponse{}, nil
+}
+
+func (s *server) RemovePeer(ctx context.Context, request *auction.RemovePeerRequest) (*auction.RemovePeerResponse, error) {
+	error := s.change(ctx, request.Address, false)
+	if error != nil {
+		return &auction.RemovePeerResponse{}, error
+	}
+
+	return &auction.RemovePeerResponse{}, nil
+}
+
+// change commits a membership with the server added or removed. Only one server is added or removed at a time,
+// so the majorities of the old and the new membership always overlap.
+func (s *server) change(ctx context.Context, address string, add bool) error {
+	s.RaftMutex.Lock()
+	if s.Role != leader {
+		s.RaftMutex.Unlock()
+		return fmt.Errorf("server %d is not the leader - leader: %d", s.Port, s.Leader)
+	}
+
+	if !slices.Equal(s.membersAt(s.CommitIndex), s.Membership) {
+		s.RaftMutex.Unlock()
+		return fmt.Errorf("another membership change is in progress")
+	}
+
+	members, error := changed(s.Membership, address, add)
+	s.RaftMutex.Unlock()
+	if error != nil {
+		return error
+	}
+
+	_, error = s.commit(ctx, &auction.LogEntry{Event: &auction.LogEntry_Membership{Membership: &auction.LogEntry_MembershipMessage{Members: members}}})
+	return error
+}
+
+// changed returns a copy of the members with the address added or removed.
+func changed(members []string, address string, add bool) ([]string, error) {
+	port, error := portOf(address)
+	if error != nil {
+		return nil, error
+	}
+
+	index := slices.IndexFunc(members, func(member string) bool {
+		other, _ := portOf(member)
+		return other == port
+	})
+
+	if add {
+		if index != -1 {
+			return nil, fmt.Errorf("server %d is already a member - address: %s", port, members[index])
+		}
+
+		return append(slices.Clone(members), address), nil
+	}
+
+	if index == -1 {
+		return nil, fmt.Errorf("server %d is not a member", port)
+	}
+
+	if len(members) == 1 {
+		return nil, fmt.Errorf("the last member can not be removed")
+	}
+
+	return slices.Delete(slices.Clone(members), index, index+1), nil
+}
+
+// membersAt returns the membership at the index, which is the newest membership change in the log up to it. RaftMutex must be held.
+func (s *server) membersAt(index int64) []string {
+	for i := min(index, s.lastIndex()); i > s.LogStart; i-- {
+		membership, ok := s.entry(i).Event.(*auction.LogEntry_Membership)
+		if ok {
+			return membership.Membership.Members
+		}
+	}
+
+	return s.Configuration
+}
+
+// member reports whether this server is in the current membership. RaftMutex must be held.
+func (s *server) member() bool {
+	for _, address := range s.Membership {
+		port, _ := portOf(address)
+		if port == s.Port {
+			return true
+		}
+	}
+
+	return false
+}
+
+// configure connects to the members of the newest membership in the log. Like in Raft, a server uses a membership
+// as soon as it is in its log, without waiting for it to be committed. RaftMutex must be held.
+func (s *server) configure() {
+	members := s.membersAt(s.lastIndex())
+	if slices.Equal(members, s.Membership) {
+		return
+	}
+
+	s.Membership = members
+	log.Printf("Members: %s", strings.Join(members, ", "))
+
+	ports := make(map[int]bool)
+	for _, address := range members {
+		port, _ := portOf(address)
+		ports[port] = true
+
+		_, ok := s.Peers[port]
+		if port == s.Port || ok {
+			continue
+		}
+
+		s.Peers[port] = s.dial(address)
+		s.NextIndex[port] = s.lastIndex() + 1
+		s.MatchIndex[port] = 0
+	}
+
+	for port, peer := range s.Peers {
+		if ports[port] {
+			continue
+		}
+
+		peer.Connection.Close()
+		delete(s.Peers, port)
+		delete(s.NextIndex, port)
+		delete(s.MatchIndex, port)
+	}
+}
+
+func (s *server) dial(address string) *peer {
+	// A restarted peer is redialled quickly instead of after gRPC's default backoff of up to two minutes.
+	connection, error := grpc.Dial(address, grpc.WithTransportCredentials(s.PeerCredentials), grpc.WithUnaryInterceptor(s.signPeer), grpc.WithConnectParams(grpc.ConnectParams{
+		Backoff:           backoff.Config{BaseDelay: heartbeatInterval, Multiplier: 1.6, MaxDelay: electionTimeout},
+		MinConnectTimeout: rpcTimeout,
+	}))
+	if error != nil {
+		log.Fatalf("Connecting to peer failed: %s", error)
+	}
+
+	return &peer{
+		Connection:            connection,
+		ElectionClient:        auction.NewElectionClient(connection),
+		ReplicationClient:     auction.NewReplicationClient(connection),
+		FailureDetectorClient: auction.NewFailureDetectorClient(connection),
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLoadMembers(t *testing.T) {
+	tests := []struct {
+		name    string
+		members string
+		want    []string
+	}{
+		{"default", "localhost:5000,localhost:5001,localhost:5002", []string{"localhost:5000", "localhost:5001", "localhost:5002"}},
+		{"spaces and empty entries", " localhost:5000, ,localhost:5001 ", []string{"localhost:5000", "localhost:5001"}},
+		{"same port on another host", "localhost:5000,10.0.0.2:5000", nil},
+		{"invalid address", "localhost", nil},
+		{"no members", ",", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			previous := *members
+			*members = test.members
+			defer func() { *members = previous }()
+
+			got, error := loadMembers()
+			if test.want == nil {
+				if error == nil {
+					t.Errorf("loadMembers = %v, want an error", got)
+				}
+			} else if error != nil || !slices.Equal(got, test.want) {
+				t.Errorf("loadMembers = %v, %v, want %v", got, error, test.want)
+			}
+		})
+	}
+}
+
+func TestChanged(t *testing.T) {
+	members := []string{"localhost:5000", "localhost:5001", "localhost:5002"}
+
+	tests := []struct {
+		name    string
+		members []string
+		address string
+		add     bool
+		want    []string
+	}{
+		{"add", members, "localhost:5003", true, []string{"localhost:5000", "localhost:5001", "localhost:5002", "localhost:5003"}},
+		{"add a member", members, "localhost:5001", true, nil},
+		{"add a port that is a member at another host", members, "127.0.0.1:5001", true, nil},
+		{"remove", members, "localhost:5001", false, []string{"localhost:5000", "localhost:5002"}},
+		{"remove by port", members, "127.0.0.1:5001", false, []string{"localhost:5000", "localhost:5002"}},
+		{"remove a server that is not a member", members, "localhost:5003", false, nil},
+		{"remove the last member", []string{"localhost:5000"}, "localhost:5000", false, nil},
+		{"invalid address", members, "localhost", true, nil},
+		{"invalid port", members, "localhost:port", true, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original := slices.Clone(test.members)
+
+			got, error := changed(test.members, test.address, test.add)
+			if test.want == nil {
+				if error == nil {
+					t.Errorf("changed = %v, want an error", got)
+				}
+			} else if error != nil || !slices.Equal(got, test.want) {
+				t.Errorf("changed = %v, %v, want %v", got, error, test.want)
+			}
+
+			if !slices.Equal(test.members, original) {
+				t.Errorf("changed modified the members to %v", test.members)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// outcome is the result of a bid applied from the log. It is kept by the request id of the bid,
+// so a retry of the bid gets the same result instead of being placed again.
+type outcome struct {
+	Response *auction.BidResponse
+	Message  string
+}
+
+// requestKey identifies a request by its bidder and the request id the bidder gave it,
+// so a bidder never gets the outcome of another bidder's request.
+type requestKey struct {
+	BidderId  int32
+	RequestId string
+}
+
+// Outcome returns the outcome of placing a bid in the lot. BidMutex must be held.
+func Outcome(lot *lot, error error) outcome {
+	if error == nil {
+		return outcome{Response: &auction.BidResponse{Outcome: auction.Outcome_SUCCESS, HighestBid: lot.highestBid()}}
+	}
+
+	rejected := status.Convert(error)
+	for _, detail := range rejected.Details() {
+		response, ok := detail.(*auction.BidResponse)
+		if ok {
+			return outcome{Response: response, Message: rejected.Message()}
+		}
+	}
+
+	return outcome{Response: &auction.BidResponse{Outcome: auction.Outcome_FAIL}, Message: rejected.Message()}
+}
+
+func (o outcome) result() (*auction.BidResponse, error) {
+	if o.Response.Outcome == auction.Outcome_SUCCESS {
+		return o.Response, nil
+	}
+
+	return &auction.BidResponse{}, bidError(o.Response.Reason, o.Response.HighestBid, "%s", o.Message)
+}
+
+// outcome returns the outcome of the request if it has been applied.
+func (s *server) outcome(auctionId int32, key requestKey) (outcome, bool) {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	lot, ok := s.Auctions[auctionId]
+	if !ok {
+		return outcome{}, false
+	}
+
+	outcome, ok := lot.Outcomes[key]
+	return outcome, ok
+}
+
+// once applies a request from the log unless the bidder's request with the same id has already been applied, and keeps its outcome,
+// so a retry that was committed again gets the outcome of the first one. Every request it applies is added to the history of the auction,
+// whether it succeeded or failed. BidMutex must be held.
+func (s *server) once(bid *auction.BidRequest, at time.Time, apply func() error) error {
+	lot, ok := s.Auctions[bid.AuctionId]
+	if !ok {
+		return apply()
+	}
+
+	key := requestKey{BidderId: bid.Id, RequestId: bid.RequestId}
+	previous, ok := lot.Outcomes[key]
+	if ok && bid.RequestId != "" {
+		_, error := previous.result()
+		return error
+	}
+
+	leading := bid.Id == int32(lot.HighestBidderId)
+	error := apply()
+	recorded := Outcome(lot, error)
+	lot.remember(bid, at, recorded, leading && error == nil)
+	if bid.RequestId != "" {
+		lot.record(key, recorded)
+	}
+
+	return error
+}
+
+// record keeps the outcome of the request and hands it to the followers waiting for it. BidMutex must be held.
+func (l *lot) record(key requestKey, outcome outcome) {
+	l.Outcomes[key] = outcome
+	for _, waiting := range l.OutcomeWaiters[key] {
+		waiting <- outcome
+	}
+	delete(l.OutcomeWaiters, key)
+}
+
+// forget stops waiting for the outcome of the request. BidMutex must be held.
+func (l *lot) forget(key requestKey, waiting chan outcome) {
+	l.OutcomeWaiters[key] = slices.DeleteFunc(l.OutcomeWaiters[key], func(other chan outcome) bool {
+		return other == waiting
+	})
+}
+
+// acknowledge waits until the bid the client also sent to the leader has been applied on this follower,
+// and returns the outcome this follower computed for it. A bid this follower rejects is never waited for.
+func (s *server) acknowledge(ctx context.Context, request *auction.BidRequest) (*auction.BidResponse, error) {
+	s.BidMutex.Lock()
+	lot, ok := s.Auctions[request.AuctionId]
+	if !ok {
+		s.BidMutex.Unlock()
+		return &auction.BidResponse{}, bidError(auction.Reason_AUCTION_NOT_FOUND, 0, "auction %d does not exist", request.AuctionId)
+	}
+
+	key := requestKey{BidderId: request.Id, RequestId: request.RequestId}
+	previous, ok := lot.Outcomes[key]
+	if ok {
+		s.BidMutex.Unlock()
+		return previous.result()
+	}
+
+	error := s.validate(request, time.Now())
+	if error != nil {
+		s.BidMutex.Unlock()
+		return &auction.BidResponse{}, error
+	}
+
+	waiting := make(chan outcome, 1)
+	lot.OutcomeWaiters[key] = append(lot.OutcomeWaiters[key], waiting)
+	s.BidMutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, commitTimeout)
+	defer cancel()
+
+	select {
+	case outcome := <-waiting:
+		return outcome.result()
+	case <-ctx.Done():
+		s.BidMutex.Lock()
+		lot.forget(key, waiting)
+		s.BidMutex.Unlock()
+
+		return &auction.BidResponse{}, status.Errorf(codes.Unavailable, "server %d did not see the bid committed in time", s.Port)
+	}
+}
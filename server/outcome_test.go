@@ -0,0 +1,48 @@
+package main
+
+import (
+	"auction/auction"
+	"testing"
+	"time"
+)
+
+func TestOnce(t *testing.T) {
+	s := testServer(testRules())
+	lot := s.Auctions[0]
+
+	bids := []struct {
+		name   string
+		bid    *auction.BidRequest
+		reason auction.Reason
+		leader int
+		amount int
+	}{
+		{"placed", &auction.BidRequest{Id: 2, Amount: 90, RequestId: "a"}, auction.Reason_NONE, 2, 90},
+		{"retry is not placed again", &auction.BidRequest{Id: 2, Amount: 90, RequestId: "a"}, auction.Reason_NONE, 2, 90},
+		{"same request id of another bidder", &auction.BidRequest{Id: 3, Amount: 95, RequestId: "a"}, auction.Reason_NONE, 3, 95},
+		{"rejected", &auction.BidRequest{Id: 4, Amount: 96, RequestId: "b"}, auction.Reason_TOO_LOW, 3, 95},
+		{"retry of a rejected bid gets the same reason", &auction.BidRequest{Id: 4, Amount: 96, RequestId: "b"}, auction.Reason_TOO_LOW, 3, 95},
+		{"new request of the same bidder", &auction.BidRequest{Id: 4, Amount: 100, RequestId: "c"}, auction.Reason_NONE, 4, 100},
+	}
+
+	for _, test := range bids {
+		error := s.auction(test.bid, time.Now())
+		if reason(error) != test.reason {
+			t.Errorf("%s: reason = %s, want %s (%v)", test.name, reason(error), test.reason, error)
+		}
+
+		if lot.HighestBidderId != test.leader || lot.HighestBid != test.amount {
+			t.Errorf("%s: bidder %d leads with %d, want bidder %d with %d", test.name, lot.HighestBidderId, lot.HighestBid, test.leader, test.amount)
+		}
+	}
+
+	outcome, ok := s.outcome(0, requestKey{BidderId: 2, RequestId: "a"})
+	if !ok || outcome.Response.Outcome != auction.Outcome_SUCCESS {
+		t.Errorf("outcome of bidder 2 = %v, %t, want SUCCESS", outcome.Response, ok)
+	}
+
+	_, ok = s.outcome(0, requestKey{BidderId: 5, RequestId: "a"})
+	if ok {
+		t.Errorf("bidder 5 got the outcome of another bidder's request")
+	}
+}
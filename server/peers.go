@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	remote "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// peerServices are the services only the servers call each other on, so the calls have to be signed with the secret.
+var peerServices = []string{"/auction.Election/", "/auction.Replication/", "/auction.FailureDetector/Heartbeat"}
+
+// signatureAge is how old a signed call may be, which allows for the clocks of the servers to differ a little.
+const signatureAge = 30 * time.Second
+
+// signPeer signs every call to another server with the secret, so the other server knows it comes from a server.
+func (s *server) signPeer(ctx context.Context, method string, request, reply any, connection *grpc.ClientConn, invoker grpc.UnaryInvoker, options ...grpc.CallOption) error {
+	timestamp := time.Now().UnixMilli()
+
+	signature, error := s.signature(method, timestamp, request)
+	if error != nil {
+		return error
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "peer-signature", strconv.FormatInt(timestamp, 10)+"."+base64.RawURLEncoding.EncodeToString(signature))
+	return invoker(ctx, method, request, reply, connection, options...)
+}
+
+// signature returns the signature of a call to the method with the request at the timestamp.
+// It covers the request, so a signature seen on an unencrypted connection can not be used for another request.
+func (s *server) signature(method string, timestamp int64, request any) ([]byte, error) {
+	message, ok := request.(proto.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "%s does not take a protocol buffer", method)
+	}
+
+	data, error := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if error != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign the call: %s", error)
+	}
+
+	mac := hmac.New(sha256.New, s.Secret)
+	mac.Write([]byte("peer\n" + method + "\n" + strconv.FormatInt(timestamp, 10) + "\n"))
+	mac.Write(data)
+
+	return mac.Sum(nil), nil
+}
+
+// authorizePeer only lets the other servers call the services of the servers. Every call has to be signed with the secret,
+// and once the connections use TLS the caller also needs a client certificate signed by the certificate authority.
+func (s *server) authorizePeer(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !isPeerService(info.FullMethod) {
+		return handler(ctx, request)
+	}
+
+	error := s.verifyPeer(ctx, info.FullMethod, request)
+	if error != nil {
+		return nil, error
+	}
+
+	if s.Credentials.Info().SecurityProtocol != "tls" {
+		return handler(ctx, request)
+	}
+
+	caller, ok := remote.FromContext(ctx)
+	if ok {
+		tlsInfo, ok := caller.AuthInfo.(credentials.TLSInfo)
+		if ok && len(tlsInfo.State.VerifiedChains) > 0 {
+			return handler(ctx, request)
+		}
+	}
+
+	return nil, status.Errorf(codes.PermissionDenied, "only servers with a certificate from the certificate authority may call %s", info.FullMethod)
+}
+
+// verifyPeer checks that the call was signed with the secret by signPeer, and recently.
+func (s *server) verifyPeer(ctx context.Context, method string, request any) error {
+	values := metadata.ValueFromIncomingContext(ctx, "peer-signature")
+	if len(values) == 0 {
+		return status.Errorf(codes.PermissionDenied, "only the servers may call %s", method)
+	}
+
+	encodedTimestamp, encodedSignature, ok := strings.Cut(values[0], ".")
+	timestamp, timestampError := strconv.ParseInt(encodedTimestamp, 10, 64)
+	signature, signatureError := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if !ok || timestampError != nil || signatureError != nil {
+		return status.Errorf(codes.PermissionDenied, "the signature of the call is malformed")
+	}
+
+	age := time.Since(time.UnixMilli(timestamp))
+	if age > signatureAge || age < -signatureAge {
+		return status.Errorf(codes.PermissionDenied, "the signature of the call is too old")
+	}
+
+	expected, error := s.signature(method, timestamp, request)
+	if error != nil {
+		return error
+	}
+
+	if !hmac.Equal(signature, expected) {
+		return status.Errorf(codes.PermissionDenied, "the call was not signed with the secret of the servers")
+	}
+
+	return nil
+}
+
+func isPeerService(method string) bool {
+	for _, service := range peerServices {
+		if strings.HasPrefix(method, service) {
+			return true
+		}
+	}
+
+	return false
+}
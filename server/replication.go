@@ -0,0 +1,242 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const commitTimeout = 2 * time.Second
+
+func (s *server) AppendEntries(_ context.Context, message *auction.AppendEntriesMessage) (*auction.AppendEntriesResponse, error) {
+	s.RaftMutex.Lock()
+	defer s.RaftMutex.Unlock()
+
+	if message.Term < s.Term {
+		return &auction.AppendEntriesResponse{Term: s.Term, Success: false}, nil
+	}
+
+	s.follow(message.Term)
+	if s.Leader != int(message.Port) {
+		log.Printf("New leader: %d", message.Port)
+	}
+	s.Leader = int(message.Port)
+	s.LastHeard = time.Now()
+
+	// A follower that is missing entries the leader has replaced by a snapshot has to catch up with the snapshot.
+	if s.lastIndex() < message.LogStart {
+		go s.catchUp()
+		return &auction.AppendEntriesResponse{Term: s.Term, Success: false}, nil
+	}
+
+	if !s.merge(message.PrevLogIndex, message.PrevLogTerm, message.Entries) {
+		// The leader can not go back further than its snapshot, so a follower whose log conflicts with the leader's
+		// at the start of the snapshot also has to catch up with the snapshot.
+		if message.PrevLogIndex <= message.LogStart {
+			go s.catchUp()
+		}
+
+		return &auction.AppendEntriesResponse{Term: s.Term, Success: false}, nil
+	}
+
+	commitIndex := min(message.LeaderCommit, message.PrevLogIndex+int64(len(message.Entries)))
+	if commitIndex > s.CommitIndex {
+		s.advance(commitIndex)
+	}
+
+	return &auction.AppendEntriesResponse{Term: s.Term, Success: true}, nil
+}
+
+// merge adds the leader's entries after prevLogIndex to the log, overwriting the entries that conflict with them.
+// It fails if the log does not have the leader's entry at prevLogIndex. RaftMutex must be held.
+func (s *server) merge(prevLogIndex int64, prevLogTerm int64, entries []*auction.LogEntry) bool {
+	// Entries covered by the snapshot are committed, so they already match the leader's.
+	if prevLogIndex < s.LogStart {
+		skip := min(s.LogStart-prevLogIndex, int64(len(entries)))
+		entries = entries[skip:]
+		prevLogIndex += skip
+		prevLogTerm = s.entry(s.LogStart).Term
+	}
+
+	if prevLogIndex > s.lastIndex() || (prevLogIndex >= s.LogStart && s.entry(prevLogIndex).Term != prevLogTerm) {
+		return false
+	}
+
+	for i, entry := range entries {
+		index := prevLogIndex + 1 + int64(i)
+		if index <= s.lastIndex() {
+			if s.entry(index).Term == entry.Term {
+				continue
+			}
+
+			s.Log = s.Log[:index-s.LogStart]
+		}
+
+		s.append(entry)
+	}
+
+	return true
+}
+
+// commit appends the entry to the leader's log and waits until it has been applied. It returns the index of the entry.
+func (s *server) commit(ctx context.Context, entry *auction.LogEntry) (int64, error) {
+	s.RaftMutex.Lock()
+	if s.Role != leader {
+		s.RaftMutex.Unlock()
+		return 0, status.Errorf(codes.Unavailable, "server %d is not the leader - leader: %d", s.Port, s.Leader)
+	}
+
+	if s.alive() < s.majority() {
+		s.RaftMutex.Unlock()
+		return 0, status.Errorf(codes.Unavailable, "only %d of %d servers are alive, so the entry can not be committed", s.alive(), len(s.Membership))
+	}
+
+	entry.Term = s.Term
+	entry.Timestamp = time.Now().UnixMilli()
+	s.append(entry)
+
+	index := s.lastIndex()
+	waiting := make(chan error, 1)
+	s.Waiting[index] = waiting
+	s.RaftMutex.Unlock()
+
+	s.broadcast()
+
+	ctx, cancel := context.WithTimeout(ctx, commitTimeout)
+	defer cancel()
+
+	select {
+	case error := <-waiting:
+		return index, error
+	case <-ctx.Done():
+		return index, status.Errorf(codes.Unavailable, "the entry was not committed by a majority of the servers in time")
+	}
+}
+
+func (s *server) broadcast() {
+	s.RaftMutex.Lock()
+	defer s.RaftMutex.Unlock()
+
+	// Suspected peers are skipped, and are caught up once the failure detector sees them again.
+	for port, peer := range s.Peers {
+		if s.Detector.alive(port) {
+			go s.replicate(port, peer)
+		}
+	}
+
+	s.commitMajority()
+}
+
+func (s *server) replicate(port int, peer *peer) {
+	s.RaftMutex.Lock()
+	if s.Role != leader {
+		s.RaftMutex.Unlock()
+		return
+	}
+
+	term := s.Term
+	prevLogIndex := max(s.NextIndex[port]-1, s.LogStart)
+	message := &auction.AppendEntriesMessage{
+		Port:         int32(s.Port),
+		Term:         s.Term,
+		PrevLogIndex: prevLogIndex,
+		PrevLogTerm:  s.entry(prevLogIndex).Term,
+		Entries:      append([]*auction.LogEntry(nil), s.Log[prevLogIndex-s.LogStart+1:]...),
+		LeaderCommit: s.CommitIndex,
+		LogStart:     s.LogStart,
+	}
+	s.RaftMutex.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+
+	response, error := peer.AppendEntries(ctx, message)
+	if error != nil {
+		return
+	}
+
+	s.RaftMutex.Lock()
+	defer s.RaftMutex.Unlock()
+
+	if response.Term > s.Term {
+		s.follow(response.Term)
+		return
+	}
+
+	if s.Role != leader || s.Term != term {
+		return
+	}
+
+	if !response.Success {
+		if s.NextIndex[port] > s.LogStart+1 {
+			s.NextIndex[port]--
+		}
+		return
+	}
+
+	matchIndex := prevLogIndex + int64(len(message.Entries))
+	if matchIndex > s.MatchIndex[port] {
+		s.MatchIndex[port] = matchIndex
+		s.NextIndex[port] = matchIndex + 1
+	}
+
+	s.commitMajority()
+}
+
+// commitMajority commits the newest entry of the current term that a majority of the servers have. RaftMutex must be held.
+func (s *server) commitMajority() {
+	for index := s.lastIndex(); index > s.CommitIndex && s.entry(index).Term == s.Term; index-- {
+		count := 0
+		if s.member() {
+			count++
+		}
+
+		for port := range s.Peers {
+			if s.MatchIndex[port] >= index {
+				count++
+			}
+		}
+
+		if count >= s.majority() {
+			s.advance(index)
+			return
+		}
+	}
+}
+
+// advance moves the commit index forward and applies the newly committed entries. RaftMutex must be held.
+func (s *server) advance(commitIndex int64) {
+	s.CommitIndex = commitIndex
+
+	for s.LastApplied < s.CommitIndex {
+		s.LastApplied++
+		error := s.apply(s.LastApplied, s.entry(s.LastApplied))
+
+		waiting, ok := s.Waiting[s.LastApplied]
+		if ok {
+			waiting <- error
+			delete(s.Waiting, s.LastApplied)
+		}
+	}
+
+	if s.LastApplied-s.LogStart >= snapshotEntries {
+		s.snapshot()
+	}
+
+	// A leader that removed itself steps down once the removal is committed.
+	if s.Role == leader && !s.member() {
+		log.Printf("Removed from the cluster")
+		s.follow(s.Term)
+	}
+}
+
+// append adds the entry to the end of the log. RaftMutex must be held.
+func (s *server) append(entry *auction.LogEntry) {
+	s.Log = append(s.Log, entry)
+	s.persist(s.lastIndex(), entry)
+	s.configure()
+}
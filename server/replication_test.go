@@ -0,0 +1,142 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// testRaft returns a server whose log holds entries of the given terms after the empty entry at index 0.
+func testRaft(terms ...int64) *server {
+	s := Server(5000, []string{"localhost:5000"}, &auction.Rules{Duration: 60, Increment: 1}, []byte("test"), insecure.NewCredentials(), insecure.NewCredentials())
+	for _, term := range terms {
+		s.Log = append(s.Log, &auction.LogEntry{Term: term})
+	}
+
+	return s
+}
+
+func logTerms(s *server) []int64 {
+	var terms []int64
+	for _, entry := range s.Log[1:] {
+		terms = append(terms, entry.Term)
+	}
+
+	return terms
+}
+
+func TestAppendEntries(t *testing.T) {
+	tests := []struct {
+		name         string
+		term         int64
+		prevLogIndex int64
+		prevLogTerm  int64
+		entries      []int64
+		leaderCommit int64
+		success      bool
+		log          []int64
+		commitIndex  int64
+	}{
+		{"append after the last entry", 2, 3, 2, []int64{2, 2}, 0, true, []int64{1, 1, 2, 2, 2}, 0},
+		{"heartbeat", 2, 3, 2, nil, 0, true, []int64{1, 1, 2}, 0},
+		{"stale term", 1, 3, 2, []int64{1}, 0, false, []int64{1, 1, 2}, 0},
+		{"missing the previous entry", 2, 5, 2, []int64{2}, 0, false, []int64{1, 1, 2}, 0},
+		{"previous entry of another term", 3, 3, 3, []int64{3}, 0, false, []int64{1, 1, 2}, 0},
+		{"conflicting entries are replaced", 3, 1, 1, []int64{3}, 0, true, []int64{1, 3}, 0},
+		{"matching entries are kept", 2, 1, 1, []int64{1}, 0, true, []int64{1, 1, 2}, 0},
+		{"commit up to the leader's commit index", 2, 3, 2, []int64{2}, 2, true, []int64{1, 1, 2, 2}, 2},
+		{"commit only up to the new entries", 2, 1, 1, []int64{1}, 3, true, []int64{1, 1, 2}, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testRaft(1, 1, 2)
+			s.Term = 2
+
+			var entries []*auction.LogEntry
+			for _, term := range test.entries {
+				entries = append(entries, &auction.LogEntry{Term: term})
+			}
+
+			response, _ := s.AppendEntries(context.Background(), &auction.AppendEntriesMessage{
+				Port:         5001,
+				Term:         test.term,
+				PrevLogIndex: test.prevLogIndex,
+				PrevLogTerm:  test.prevLogTerm,
+				Entries:      entries,
+				LeaderCommit: test.leaderCommit,
+			})
+
+			if response.Success != test.success {
+				t.Errorf("success = %t, want %t", response.Success, test.success)
+			}
+
+			if !slices.Equal(logTerms(s), test.log) {
+				t.Errorf("log = %v, want %v", logTerms(s), test.log)
+			}
+
+			if s.CommitIndex != test.commitIndex || s.LastApplied != test.commitIndex {
+				t.Errorf("commit index = %d, last applied = %d, want %d", s.CommitIndex, s.LastApplied, test.commitIndex)
+			}
+		})
+	}
+}
+
+func TestCommitMajority(t *testing.T) {
+	tests := []struct {
+		name        string
+		matchIndex  map[int]int64
+		commitIndex int64
+	}{
+		{"nothing replicated", map[int]int64{5001: 0, 5002: 0}, 0},
+		{"replicated to one of two peers", map[int]int64{5001: 3, 5002: 0}, 3},
+		{"majority on an earlier entry", map[int]int64{5001: 2, 5002: 2}, 2},
+		{"entries of earlier terms are not committed by counting", map[int]int64{5001: 1, 5002: 1}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testRaft(1, 2, 2)
+			s.Term = 2
+			s.Role = leader
+			for port, matchIndex := range test.matchIndex {
+				s.Peers[port] = nil
+				s.MatchIndex[port] = matchIndex
+			}
+
+			s.commitMajority()
+
+			if s.CommitIndex != test.commitIndex {
+				t.Errorf("commit index = %d, want %d", s.CommitIndex, test.commitIndex)
+			}
+		})
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	s := testRaft(1, 1, 1)
+	waiting := make(chan error, 1)
+	s.Waiting[2] = waiting
+
+	s.advance(2)
+
+	if s.CommitIndex != 2 || s.LastApplied != 2 {
+		t.Errorf("commit index = %d, last applied = %d, want 2", s.CommitIndex, s.LastApplied)
+	}
+
+	select {
+	case error := <-waiting:
+		if error != nil {
+			t.Errorf("the entry failed: %s", error)
+		}
+	default:
+		t.Errorf("the commit of entry 2 was not waited for")
+	}
+
+	if _, ok := s.Waiting[2]; ok {
+		t.Errorf("entry 2 is still waited for")
+	}
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"auction/auction"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Commit takes the commitment to a sealed bid. The servers only learn the amount when the bid is revealed after the bidding.
+func (s *server) Commit(ctx context.Context, request *auction.CommitRequest) (*auction.BidResponse, error) {
+	error := s.ready()
+	if error != nil {
+		return &auction.BidResponse{}, error
+	}
+
+	bidder, error := s.authenticate(ctx)
+	if error != nil {
+		return &auction.BidResponse{}, error
+	}
+
+	if request.RequestId == "" {
+		return &auction.BidResponse{}, status.Errorf(codes.InvalidArgument, "the commitment has no request id")
+	}
+
+	// A retried commitment gets the outcome it got the first time.
+	key := requestKey{BidderId: bidder.Id, RequestId: request.RequestId}
+	outcome, ok := s.outcome(request.AuctionId, key)
+	if ok {
+		return outcome.result()
+	}
+
+	// Commitments that fail are committed too, so every server has them in the history of the auction.
+	s.BidMutex.Lock()
+	_, ok = s.Auctions[request.AuctionId]
+	s.BidMutex.Unlock()
+	if !ok {
+		return &auction.BidResponse{}, bidError(auction.Reason_AUCTION_NOT_FOUND, 0, "auction %d does not exist", request.AuctionId)
+	}
+
+	message := &auction.LogEntry_CommitMessage{AuctionId: request.AuctionId, BidderId: bidder.Id, Name: bidder.Name, Commitment: request.Commitment, RequestId: request.RequestId}
+	_, error = s.commit(ctx, &auction.LogEntry{Event: &auction.LogEntry_Commitment{Commitment: message}})
+
+	outcome, ok = s.outcome(request.AuctionId, key)
+	if ok {
+		return outcome.result()
+	}
+
+	return &auction.BidResponse{}, error
+}
+
+// Reveal takes the amount and the nonce of a sealed bid once the bidding is over, and places the bid if they match the commitment.
+func (s *server) Reveal(ctx context.Context, request *auction.RevealRequest) (*auction.BidResponse, error) {
+	error := s.ready()
+	if error != nil {
+		return &auction.BidResponse{}, error
+	}
+
+	bidder, error := s.authenticate(ctx)
+	if error != nil {
+		return &auction.BidResponse{}, error
+	}
+
+	if request.RequestId == "" {
+		return &auction.BidResponse{}, status.Errorf(codes.InvalidArgument, "the reveal has no request id")
+	}
+
+	// A retried reveal gets the outcome it got the first time.
+	key := requestKey{BidderId: bidder.Id, RequestId: request.RequestId}
+	outcome, ok := s.outcome(request.AuctionId, key)
+	if ok {
+		return outcome.result()
+	}
+
+	// Reveals that fail are committed too, so every server has them in the history of the auction.
+	s.BidMutex.Lock()
+	_, ok = s.Auctions[request.AuctionId]
+	s.BidMutex.Unlock()
+	if !ok {
+		return &auction.BidResponse{}, bidError(auction.Reason_AUCTION_NOT_FOUND, 0, "auction %d does not exist", request.AuctionId)
+	}
+
+	message := &auction.LogEntry_RevealMessage{AuctionId: request.AuctionId, BidderId: bidder.Id, Name: bidder.Name, Amount: request.Amount, Nonce: request.Nonce, RequestId: request.RequestId}
+	_, error = s.commit(ctx, &auction.LogEntry{Event: &auction.LogEntry_Reveal{Reveal: message}})
+
+	outcome, ok = s.outcome(request.AuctionId, key)
+	if ok {
+		return outcome.result()
+	}
+
+	return &auction.BidResponse{}, error
+}
+
+// commitBid applies a commitment from the log. It is kept in the history without an amount, and not in the ledger until it is revealed.
+func (s *server) commitBid(index int64, message *auction.LogEntry_CommitMessage, at time.Time) error {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	bid := &auction.BidRequest{Id: message.BidderId, Name: message.Name, AuctionId: message.AuctionId, RequestId: message.RequestId}
+	return s.once(bid, at, func() error {
+		return s.addCommitment(index, message, at)
+	})
+}
+
+// addCommitment keeps the commitment of the bidder with the index of its log entry, which decides ties,
+// and starts the auction if it is the first. BidMutex must be held.
+func (s *server) addCommitment(index int64, message *auction.LogEntry_CommitMessage, at time.Time) error {
+	error := s.validateCommit(message, at)
+	if error != nil {
+		return error
+	}
+
+	lot := s.Auctions[message.AuctionId]
+	lot.Commitments[message.BidderId] = commitment{Hash: message.Commitment, Index: index}
+	if !lot.Started {
+		s.begin(lot, at)
+	}
+
+	lot.publish()
+	return nil
+}
+
+// reveal applies a revealed bid from the log, which places it among the sealed bids. It is kept in the history with its amount.
+func (s *server) reveal(message *auction.LogEntry_RevealMessage, at time.Time) error {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	bid := &auction.BidRequest{Id: message.BidderId, Name: message.Name, Amount: message.Amount, AuctionId: message.AuctionId, RequestId: message.RequestId}
+	return s.once(bid, at, func() error {
+		return s.revealBid(bid, message, at)
+	})
+}
+
+// revealBid places the revealed bid among the sealed bids if it matches the commitment. BidMutex must be held.
+func (s *server) revealBid(bid *auction.BidRequest, message *auction.LogEntry_RevealMessage, at time.Time) error {
+	error := s.validateReveal(message, at)
+	if error != nil {
+		return error
+	}
+
+	lot := s.Auctions[message.AuctionId]
+	lot.SealedBids = append(lot.SealedBids, bid)
+
+	log.Printf("Auction %d: %s revealed a bid", lot.Id, message.Name)
+	return nil
+}
+
+// validateCommit checks that the auction takes commitments. BidMutex must be held.
+func (s *server) validateCommit(message *auction.LogEntry_CommitMessage, at time.Time) error {
+	lot, ok := s.Auctions[message.AuctionId]
+	if !ok {
+		return bidError(auction.Reason_AUCTION_NOT_FOUND, 0, "auction %d does not exist", message.AuctionId)
+	}
+
+	if !lot.sealed() {
+		return bidError(auction.Reason_NOT_BIDDABLE, lot.highestBid(), "only sealed auctions take commitments")
+	}
+
+	if lot.Finished || lot.Revealing || (lot.Started && !at.Before(lot.Deadline)) {
+		return bidError(auction.Reason_AUCTION_DONE, lot.highestBid(), "the bidding is over")
+	}
+
+	if !lot.Started && lot.Rules.Start != auction.StartMode_FIRST_BID {
+		return bidError(auction.Reason_NOT_STARTED, lot.highestBid(), "auction has not started yet")
+	}
+
+	if len(message.Commitment) != sha256.Size {
+		return bidError(auction.Reason_INVALID_COMMITMENT, lot.highestBid(), "a commitment is a SHA-256 hash of %d bytes", sha256.Size)
+	}
+
+	_, committed := lot.Commitments[message.BidderId]
+	if committed {
+		return bidError(auction.Reason_ALREADY_BID, lot.highestBid(), "you have already placed a bid in this sealed auction")
+	}
+
+	return nil
+}
+
+// validateReveal checks that the revealed bid matches the commitment of the bidder. BidMutex must be held.
+func (s *server) validateReveal(message *auction.LogEntry_RevealMessage, at time.Time) error {
+	lot, ok := s.Auctions[message.AuctionId]
+	if !ok {
+		return bidError(auction.Reason_AUCTION_NOT_FOUND, 0, "auction %d does not exist", message.AuctionId)
+	}
+
+	if !lot.sealed() {
+		return bidError(auction.Reason_NOT_BIDDABLE, lot.highestBid(), "only sealed bids are revealed")
+	}
+
+	if lot.Finished || (lot.Revealing && !at.Before(lot.Deadline)) {
+		return bidError(auction.Reason_AUCTION_DONE, lot.highestBid(), "the time to reveal the bids is over")
+	}
+
+	if !lot.Revealing {
+		return bidError(auction.Reason_NOT_REVEALING, lot.highestBid(), "the bids are revealed once the bidding is over")
+	}
+
+	commitment, ok := lot.Commitments[message.BidderId]
+	if !ok {
+		return bidError(auction.Reason_NO_COMMITMENT, lot.highestBid(), "you did not commit to a bid in this auction")
+	}
+
+	for _, sealedBid := range lot.SealedBids {
+		if sealedBid.Id == message.BidderId {
+			return bidError(auction.Reason_ALREADY_BID, lot.highestBid(), "you have already revealed your bid")
+		}
+	}
+
+	if !bytes.Equal(commitment.Hash, auction.Commitment(message.AuctionId, message.BidderId, message.Amount, message.Nonce)) {
+		return bidError(auction.Reason_INVALID_COMMITMENT, lot.highestBid(), "the amount and the nonce do not match your commitment")
+	}
+
+	if message.Amount < lot.Rules.StartingBid {
+		return bidError(auction.Reason_BELOW_STARTING_BID, lot.highestBid(), "your bid has to be at least the starting bid - your bid: %d - starting bid: %d", message.Amount, lot.Rules.StartingBid)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"auction/auction"
+	"testing"
+	"time"
+)
+
+func TestCommitAndReveal(t *testing.T) {
+	rules := testRules()
+	rules.Type = auction.AuctionType_SEALED
+	rules.RevealWindow = 30
+	s := testServer(rules)
+
+	lot := s.Auctions[0]
+	lot.HighestBidderId = 0
+	lot.HighestBid = 0
+	lot.HighestMaximum = 0
+
+	nonce := []byte("nonce")
+	hash := auction.Commitment(0, 2, 70, nonce)
+
+	steps := []struct {
+		name   string
+		apply  func() error
+		reason auction.Reason
+	}{
+		{"commit", func() error {
+			return s.commitBid(3, &auction.LogEntry_CommitMessage{BidderId: 2, Name: "second", Commitment: hash, RequestId: "a"}, time.Now())
+		}, auction.Reason_NONE},
+		{"retried commitment", func() error {
+			return s.commitBid(4, &auction.LogEntry_CommitMessage{BidderId: 2, Name: "second", Commitment: hash, RequestId: "a"}, time.Now())
+		}, auction.Reason_NONE},
+		{"second commitment", func() error {
+			return s.commitBid(5, &auction.LogEntry_CommitMessage{BidderId: 2, Name: "second", Commitment: hash, RequestId: "b"}, time.Now())
+		}, auction.Reason_ALREADY_BID},
+		{"reveal during the bidding", func() error {
+			return s.reveal(&auction.LogEntry_RevealMessage{BidderId: 2, Name: "second", Amount: 70, Nonce: nonce, RequestId: "c"}, time.Now())
+		}, auction.Reason_NOT_REVEALING},
+		{"reveal another amount", func() error {
+			lot.Revealing = true
+			return s.reveal(&auction.LogEntry_RevealMessage{BidderId: 2, Name: "second", Amount: 90, Nonce: nonce, RequestId: "d"}, time.Now())
+		}, auction.Reason_INVALID_COMMITMENT},
+		{"reveal", func() error {
+			return s.reveal(&auction.LogEntry_RevealMessage{BidderId: 2, Name: "second", Amount: 70, Nonce: nonce, RequestId: "e"}, time.Now())
+		}, auction.Reason_NONE},
+		{"retried reveal", func() error {
+			return s.reveal(&auction.LogEntry_RevealMessage{BidderId: 2, Name: "second", Amount: 70, Nonce: nonce, RequestId: "e"}, time.Now())
+		}, auction.Reason_NONE},
+	}
+
+	for _, step := range steps {
+		error := step.apply()
+		if reason(error) != step.reason {
+			t.Errorf("%s: reason = %s, want %s (%v)", step.name, reason(error), step.reason, error)
+		}
+	}
+
+	if lot.Commitments[2].Index != 3 {
+		t.Errorf("the commitment is at index %d, want 3", lot.Commitments[2].Index)
+	}
+
+	if len(lot.SealedBids) != 1 {
+		t.Errorf("%d sealed bids were revealed, want 1", len(lot.SealedBids))
+	}
+
+	// The retries are not kept again, but every request that failed is.
+	if len(lot.History) != 5 {
+		t.Errorf("the history has %d records, want 5", len(lot.History))
+	}
+}
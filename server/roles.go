@@ -0,0 +1,83 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var everyone = []auction.Role{auction.Role_AUCTIONEER, auction.Role_BIDDER, auction.Role_OBSERVER}
+
+// permissions are the roles that may call each method. The methods that are not in it, like logging in,
+// finding the members and the calls between the servers, do not need a token.
+var permissions = map[string][]auction.Role{
+	"/auction.Auction/CreateAuction": {auction.Role_AUCTIONEER},
+	"/auction.Auction/StartAuction":  {auction.Role_AUCTIONEER},
+	"/auction.Auction/CloseAuction":  {auction.Role_AUCTIONEER},
+	"/auction.Membership/AddPeer":    {auction.Role_AUCTIONEER},
+	"/auction.Membership/RemovePeer": {auction.Role_AUCTIONEER},
+
+	"/auction.Auction/Bid":    {auction.Role_BIDDER},
+	"/auction.Auction/Accept": {auction.Role_BIDDER},
+	"/auction.Auction/Commit": {auction.Role_BIDDER},
+	"/auction.Auction/Reveal": {auction.Role_BIDDER},
+
+	"/auction.Auction/Result":       everyone,
+	"/auction.Auction/WatchAuction": everyone,
+	"/auction.Auction/ListAuctions": everyone,
+	"/auction.Auction/BidHistory":   everyone,
+	"/auction.Auction/Ledger":       everyone,
+	"/auction.FailureDetector/View": everyone,
+}
+
+// authorize only lets the users with a role that is allowed to call the method call it.
+func (s *server) authorize(ctx context.Context, method string) error {
+	roles, ok := permissions[method]
+	if !ok {
+		return nil
+	}
+
+	user, error := s.authenticate(ctx)
+	if error != nil {
+		return error
+	}
+
+	if !slices.Contains(roles, user.Role) {
+		return status.Errorf(codes.PermissionDenied, "%s is %s, so %s may not be called", user.Name, article(user.Role), method)
+	}
+
+	return nil
+}
+
+func (s *server) authorizeUnary(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	error := s.authorize(ctx, info.FullMethod)
+	if error != nil {
+		return nil, error
+	}
+
+	return handler(ctx, request)
+}
+
+func (s *server) authorizeStream(service any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	error := s.authorize(stream.Context(), info.FullMethod)
+	if error != nil {
+		return error
+	}
+
+	return handler(service, stream)
+}
+
+// article returns the role with "a" or "an" in front of it.
+func article(role auction.Role) string {
+	name := strings.ToLower(role.String())
+	if role == auction.Role_AUCTIONEER || role == auction.Role_OBSERVER {
+		return "an " + name
+	}
+
+	return "a " + name
+}
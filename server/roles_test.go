@@ -0,0 +1,48 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthorize(t *testing.T) {
+	s := testServer(testRules())
+
+	// token returns the context of a call by a user with the role.
+	token := func(role auction.Role) context.Context {
+		token, _ := s.sign(identity{Id: 1, Name: "user", Role: role, Expires: time.Now().Add(time.Hour).Unix()})
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   codes.Code
+	}{
+		{"auctioneer creates", token(auction.Role_AUCTIONEER), "/auction.Auction/CreateAuction", codes.OK},
+		{"bidder creates", token(auction.Role_BIDDER), "/auction.Auction/CreateAuction", codes.PermissionDenied},
+		{"bidder bids", token(auction.Role_BIDDER), "/auction.Auction/Bid", codes.OK},
+		{"auctioneer bids", token(auction.Role_AUCTIONEER), "/auction.Auction/Bid", codes.PermissionDenied},
+		{"observer bids", token(auction.Role_OBSERVER), "/auction.Auction/Bid", codes.PermissionDenied},
+		{"observer watches", token(auction.Role_OBSERVER), "/auction.Auction/WatchAuction", codes.OK},
+		{"bidder adds a server", token(auction.Role_BIDDER), "/auction.Membership/AddPeer", codes.PermissionDenied},
+		{"without a token", context.Background(), "/auction.Auction/Result", codes.Unauthenticated},
+		{"logging in without a token", context.Background(), "/auction.Authentication/Login", codes.OK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			error := s.authorize(test.ctx, test.method)
+			if status.Code(error) != test.code {
+				t.Errorf("authorize = %v, want %s", error, test.code)
+			}
+		})
+	}
+}
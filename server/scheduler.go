@@ -0,0 +1,125 @@
+package main
+
+import (
+	"auction/auction"
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// timer closes the auction at its deadline and sends the countdown to the watchers every second. It stops when ctx is cancelled.
+func (s *server) timer(ctx context.Context, id int32) {
+	log.Printf("Time started for auction %d", id)
+
+	end := time.NewTimer(s.untilDeadline(id))
+	defer end.Stop()
+
+	tick := time.NewTicker(time.Second)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+			s.BidMutex.Lock()
+			lot := s.Auctions[id]
+			lot.publish()
+			remaining := lot.remaining()
+			s.BidMutex.Unlock()
+
+			if remaining%10 == 0 {
+				log.Printf("Auction %d: %d", id, remaining)
+			}
+		case <-end.C:
+			// Late bids move the deadline, so it is checked again before closing.
+			if s.untilDeadline(id) <= 0 {
+				s.propose(ctx, &auction.LogEntry{Event: &auction.LogEntry_Close{Close: &auction.LogEntry_CloseMessage{AuctionId: id, Expired: true}}}, func() bool {
+					return s.isFinished(id) || s.untilDeadline(id) > 0
+				})
+			}
+
+			if s.isFinished(id) {
+				return
+			}
+
+			end.Reset(s.untilDeadline(id))
+		}
+	}
+}
+
+func (s *server) untilDeadline(id int32) time.Duration {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	return time.Until(s.Auctions[id].Deadline)
+}
+
+func (s *server) isFinished(id int32) bool {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	return s.Auctions[id].Finished
+}
+
+// resume starts the timers of the auctions the server already knows about.
+func (s *server) resume() {
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	for id, lot := range s.Auctions {
+		if !lot.Started {
+			go s.schedule(lot.Context, id)
+		} else if !lot.Finished {
+			go s.timer(lot.Context, id)
+		}
+	}
+}
+
+// schedule starts an auction at its start time if it has one. It stops when ctx is cancelled.
+func (s *server) schedule(ctx context.Context, id int32) {
+	s.BidMutex.Lock()
+	rules := s.Auctions[id].Rules
+	s.BidMutex.Unlock()
+
+	if rules.Start != auction.StartMode_AT_TIME {
+		return
+	}
+
+	start := time.NewTimer(time.Until(time.Unix(rules.StartAt, 0)))
+	defer start.Stop()
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-start.C:
+	}
+
+	s.propose(ctx, &auction.LogEntry{Event: &auction.LogEntry_Start{Start: &auction.LogEntry_StartMessage{AuctionId: id}}}, func() bool {
+		s.BidMutex.Lock()
+		defer s.BidMutex.Unlock()
+
+		return s.Auctions[id].Started
+	})
+}
+
+// propose commits the entry whenever this server is the leader, until done reports true or ctx is cancelled.
+// Only the leader proposes these entries, so every server starts and finishes the auction at the same point in the log.
+func (s *server) propose(ctx context.Context, entry *auction.LogEntry, done func() bool) {
+	retry := time.NewTicker(heartbeatInterval)
+	defer retry.Stop()
+
+	for !done() {
+		if s.leader() == s.Port {
+			s.commit(ctx, proto.Clone(entry).(*auction.LogEntry))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-retry.C:
+		}
+	}
+}
@@ -4,64 +4,171 @@ import (
 	"auction/auction"
 	"context"
 	"flag"
-	"fmt"
 	"log"
 	"net"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 var port = flag.Int("port", 5000, "The id of the client")
 
+type role int
+
+const (
+	follower role = iota
+	candidate
+	leader
+)
+
 type server struct {
 	Port int
 
-	HighestBidderId   int
-	HighestBidderName string
-	HighestBid        int
+	Role      role
+	Leader    int
+	Term      int64
+	VotedFor  int
+	LastHeard time.Time
+
+	Log         []*auction.LogEntry
+	LogStart    int64
+	CommitIndex int64
+	LastApplied int64
+	NextIndex   map[int]int64
+	MatchIndex  map[int]int64
+	Waiting     map[int64]chan error
+
+	// Ready is set once the server has caught up with the leader.
+	Ready      bool
+	CatchingUp bool
+
+	RaftMutex sync.Mutex
+	Storage   *storage
 
-	Time int
+	// Configuration is the membership before the first entry of the log, and Membership is the newest one in the log.
+	Configuration []string
+	Membership    []string
+	Peers         map[int]*peer
+	Detector      *detector
 
-	Started  bool
-	Finished bool
+	// Credentials are what the server serves with, and PeerCredentials what it dials the other servers with.
+	Credentials     credentials.TransportCredentials
+	PeerCredentials credentials.TransportCredentials
 
+	Rules *auction.Rules
+
+	// Secret is the key the tokens are signed with, and Accounts are the registered bidders by name.
+	Secret   []byte
+	Accounts map[string]*account
+
+	Auctions map[int32]*lot
 	BidMutex sync.Mutex
 
 	auction.UnimplementedAuctionServer
+	auction.UnimplementedAuthenticationServer
+	auction.UnimplementedElectionServer
+	auction.UnimplementedReplicationServer
+	auction.UnimplementedMembershipServer
+	auction.UnimplementedFailureDetectorServer
 }
 
-func Server(port int) *server {
+type peer struct {
+	Connection *grpc.ClientConn
+	auction.ElectionClient
+	auction.ReplicationClient
+	auction.FailureDetectorClient
+}
+
+func Server(port int, members []string, rules *auction.Rules, secret []byte, serving credentials.TransportCredentials, dialing credentials.TransportCredentials) *server {
 	return &server{
 		Port: port,
 
-		HighestBid: 50,
-		Time:       120,
+		Log:        []*auction.LogEntry{{}},
+		NextIndex:  make(map[int]int64),
+		MatchIndex: make(map[int]int64),
+		Waiting:    make(map[int64]chan error),
+
+		Configuration: members,
+		Peers:         make(map[int]*peer),
+		Detector:      Detector(),
 
-		Started:  false,
-		Finished: false,
+		Credentials:     serving,
+		PeerCredentials: dialing,
+
+		Rules: rules,
+
+		Secret:   secret,
+		Accounts: make(map[string]*account),
+
+		Auctions: make(map[int32]*lot),
 	}
 }
 
 func main() {
 	flag.Parse()
 
-	s := Server(*port)
+	rules, error := loadRules()
+	if error != nil {
+		log.Fatalf("Invalid rules: %s", error)
+	}
+
+	members, error := loadMembers()
+	if error != nil {
+		log.Fatalf("Invalid members: %s", error)
+	}
+
+	serving, dialing, error := loadCredentials()
+	if error != nil {
+		log.Fatalf("Invalid certificates: %s", error)
+	}
+
+	secret, error := loadSecret()
+	if error != nil {
+		log.Fatalf("Invalid secret: %s", error)
+	}
+
+	s := Server(*port, members, rules, secret, serving, dialing)
+
+	error = s.recover(filepath.Join(*dataDirectory, strconv.Itoa(*port)))
+	if error != nil {
+		log.Fatalf("Failed to recover: %s", error)
+	}
+
 	s.server()
 }
 
 func (s *server) server() {
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		grpc.Creds(s.Credentials),
+		grpc.ChainUnaryInterceptor(s.authorizePeer, s.authorizeUnary),
+		grpc.ChainStreamInterceptor(s.authorizeStream),
+	)
 	auction.RegisterAuctionServer(server, s)
+	auction.RegisterAuthenticationServer(server, s)
+	auction.RegisterElectionServer(server, s)
+	auction.RegisterReplicationServer(server, s)
+	auction.RegisterMembershipServer(server, s)
+	auction.RegisterFailureDetectorServer(server, s)
 
 	listener, error := net.Listen("tcp", ":"+strconv.Itoa(s.Port))
 	if error != nil {
 		log.Fatalf("Failed to listen: %s", error)
 	}
 
-	go s.timer()
+	s.RaftMutex.Lock()
+	s.configure()
+	s.RaftMutex.Unlock()
+
+	go s.heartbeat()
+	go s.monitor()
+	go s.catchUp()
+	s.resume()
 
 	error = server.Serve(listener)
 	if error != nil {
@@ -69,87 +176,218 @@ func (s *server) server() {
 	}
 }
 
-func (s *server) Bid(_ context.Context, request *auction.BidRequest) (*auction.BidResponse, error) {
-	error := s.auction(request)
+func (s *server) Bid(ctx context.Context, request *auction.BidRequest) (*auction.BidResponse, error) {
+	error := s.ready()
+	if error != nil {
+		return &auction.BidResponse{}, error
+	}
+
+	bidder, error := s.authenticate(ctx)
 	if error != nil {
 		return &auction.BidResponse{}, error
 	}
 
-	if !s.Started {
-		s.Started = true
+	request.Id = bidder.Id
+	request.Name = bidder.Name
+
+	if request.RequestId == "" {
+		return &auction.BidResponse{}, status.Errorf(codes.InvalidArgument, "the bid has no request id")
+	}
+
+	// A retried bid gets the outcome it got the first time.
+	key := requestKey{BidderId: request.Id, RequestId: request.RequestId}
+	outcome, ok := s.outcome(request.AuctionId, key)
+	if ok {
+		return outcome.result()
+	}
+
+	s.RaftMutex.Lock()
+	role := s.Role
+	s.RaftMutex.Unlock()
+
+	if role != leader {
+		return s.acknowledge(ctx, request)
+	}
+
+	// Bids that fail are committed too, so every server has them in the history of the auction.
+	s.BidMutex.Lock()
+	_, ok = s.Auctions[request.AuctionId]
+	s.BidMutex.Unlock()
+	if !ok {
+		return &auction.BidResponse{}, bidError(auction.Reason_AUCTION_NOT_FOUND, 0, "auction %d does not exist", request.AuctionId)
+	}
+
+	_, error = s.commit(ctx, &auction.LogEntry{Event: &auction.LogEntry_Bid{Bid: request}})
+
+	outcome, ok = s.outcome(request.AuctionId, key)
+	if ok {
+		return outcome.result()
 	}
 
-	return &auction.BidResponse{}, nil
+	return &auction.BidResponse{}, error
 }
 
 func (s *server) Result(_ context.Context, request *auction.ResultRequest) (*auction.ResultResponse, error) {
-	if !s.Started {
-		return &auction.ResultResponse{
-			Event: &auction.ResultResponse_Status{
-				Status: &auction.ResultResponse_StatusMessage{
-					Time:       int64(s.Time),
-					HighestBid: int64(s.HighestBid),
-				},
-			},
-		}, nil
-	} else if s.Finished {
-		return &auction.ResultResponse{
-			Event: &auction.ResultResponse_Winner{
-				Winner: &auction.ResultResponse_WinnerMessage{
-					Name:   s.HighestBidderName,
-					Amount: int64(s.HighestBid),
-				},
-			},
-		}, nil
-	} else {
-		return &auction.ResultResponse{
-			Event: &auction.ResultResponse_Status{
-				Status: &auction.ResultResponse_StatusMessage{
-					Time:       int64(s.Time),
-					HighestBid: int64(s.HighestBid),
-				},
-			},
-		}, nil
+	error := s.ready()
+	if error != nil {
+		return &auction.ResultResponse{}, error
 	}
+
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	lot, ok := s.Auctions[request.AuctionId]
+	if !ok {
+		return &auction.ResultResponse{}, missing(request.AuctionId)
+	}
+
+	return lot.result(), nil
 }
 
-func (s *server) auction(bid *auction.BidRequest) error {
-	if s.Finished {
-		return fmt.Errorf("auction is done")
+// missing returns the error for an auction that does not exist. The default auction is created by the first leader,
+// so until then it is only unavailable.
+func missing(id int32) error {
+	if id == 0 {
+		return status.Errorf(codes.Unavailable, "the default auction is created once a leader has been elected")
 	}
 
-	if bid.Id == int32(s.HighestBidderId) {
-		return fmt.Errorf("you can not raise your own bid")
+	return status.Errorf(codes.NotFound, "auction %d does not exist", id)
+}
+
+// apply runs a committed log entry. Every server applies the same entries in the same order, so they all reach the same decisions.
+func (s *server) apply(index int64, entry *auction.LogEntry) error {
+	// The leader's timestamp is used instead of the local clock, so every server computes the same deadlines.
+	at := time.UnixMilli(entry.Timestamp)
+
+	// Entries without an event are the no-ops a new leader commits.
+	switch event := entry.Event.(type) {
+	case *auction.LogEntry_Bid:
+		return s.auction(event.Bid, at)
+	case *auction.LogEntry_Close:
+		return s.close(event.Close.AuctionId, at, event.Close.Expired)
+	case *auction.LogEntry_Create:
+		id := int32(index)
+		if event.Create.Initial {
+			id = 0
+		}
+
+		s.create(id, event.Create.Name, event.Create.Rules)
+	case *auction.LogEntry_Start:
+		return s.start(event.Start.AuctionId, at)
+	case *auction.LogEntry_Accept:
+		return s.accept(event.Accept, at)
+	case *auction.LogEntry_Commitment:
+		return s.commitBid(index, event.Commitment, at)
+	case *auction.LogEntry_Reveal:
+		return s.reveal(event.Reveal, at)
+	case *auction.LogEntry_Register:
+		return s.register(int32(index), event.Register)
+	case *auction.LogEntry_Membership:
+		// A membership change already took effect when it was added to the log.
+		log.Printf("Membership change committed at index %d", index)
 	}
 
+	return nil
+}
+
+// auction applies a bid from the log, and keeps its outcome for retries, for the followers that acknowledge it
+// and in the history of the auction. A bid that is in the log twice is only placed the first time.
+func (s *server) auction(bid *auction.BidRequest, at time.Time) error {
 	s.BidMutex.Lock()
 	defer s.BidMutex.Unlock()
-	if int(bid.Amount) > s.HighestBid {
-		s.HighestBidderId = int(bid.Id)
-		s.HighestBidderName = bid.Name
-		s.HighestBid = int(bid.Amount)
+
+	return s.once(bid, at, func() error {
+		return s.place(bid, at)
+	})
+}
+
+// place decides the bid. BidMutex must be held.
+func (s *server) place(bid *auction.BidRequest, at time.Time) error {
+	error := s.validate(bid, at)
+	if error != nil {
+		return error
+	}
+
+	lot := s.Auctions[bid.AuctionId]
+	if bid.Id == int32(lot.HighestBidderId) {
+		lot.HighestMaximum = int(bid.Maximum)
+		return nil
+	}
+
+	// The bidder with the highest maximum leads, paying one increment more than the runner-up's maximum.
+	maximum := int(max(bid.Amount, bid.Maximum))
+	increment := int(lot.Rules.Increment)
+	outbid := maximum <= lot.HighestMaximum
+	if !outbid {
+		lot.HighestBid = int(bid.Amount)
+		if lot.HighestMaximum+increment > lot.HighestBid {
+			lot.HighestBid = min(maximum, lot.HighestMaximum+increment)
+		}
+
+		lot.HighestBidderId = int(bid.Id)
+		lot.HighestBidderName = bid.Name
+		lot.HighestMaximum = maximum
 	} else {
-		return fmt.Errorf("your bid has to be higher than the biggest bid - your bid: %d - highest bid: %d", bid.Amount, s.HighestBid)
+		lot.HighestBid = min(lot.HighestMaximum, maximum+increment)
+	}
+
+	if !lot.Started {
+		s.begin(lot, at)
+	} else if lot.Deadline.Sub(at) < time.Duration(lot.Rules.ExtensionWindow)*time.Second {
+		lot.Deadline = lot.Deadline.Add(time.Duration(lot.Rules.Extension) * time.Second)
+		lot.Extended += lot.Rules.Extension
+		log.Printf("Auction %d extended by %d seconds", lot.Id, lot.Rules.Extension)
+	}
+
+	// An outbid bid raises the highest bid too, so it is chained whether it was placed or not.
+	lot.chain(at)
+	lot.publish()
+
+	if outbid {
+		return bidError(auction.Reason_OUTBID, lot.highestBid(), "you were outbid by the maximum bid of another bidder - highest bid: %d", lot.HighestBid)
 	}
 
 	return nil
 }
 
-func (s *server) timer() {
-	for !s.Started {
+func (s *server) validate(bid *auction.BidRequest, at time.Time) error {
+	lot, ok := s.Auctions[bid.AuctionId]
+	if !ok {
+		return bidError(auction.Reason_AUCTION_NOT_FOUND, 0, "auction %d does not exist", bid.AuctionId)
+	}
+
+	if lot.Finished || (lot.Started && !at.Before(lot.Deadline)) {
+		return bidError(auction.Reason_AUCTION_DONE, lot.highestBid(), "auction is done")
+	}
 
+	if !lot.Started && lot.Rules.Start != auction.StartMode_FIRST_BID {
+		return bidError(auction.Reason_NOT_STARTED, lot.highestBid(), "auction has not started yet")
 	}
 
-	log.Printf("Time started")
+	if lot.Rules.Type == auction.AuctionType_DUTCH {
+		return bidError(auction.Reason_NOT_BIDDABLE, lot.highestBid(), "write /accept to accept the current price of a dutch auction")
+	}
 
-	for s.Time > 0 {
-		time.Sleep(time.Second)
-		s.Time--
+	if lot.sealed() {
+		return bidError(auction.Reason_NOT_BIDDABLE, lot.highestBid(), "a sealed auction only takes commitments to bids, which are revealed after the bidding")
+	}
 
-		if s.Time%10 == 0 {
-			log.Print(s.Time)
+	if bid.Maximum != 0 && bid.Maximum < bid.Amount {
+		return bidError(auction.Reason_MAXIMUM_BELOW_BID, lot.highestBid(), "your maximum bid can not be lower than your bid - your bid: %d - your maximum bid: %d", bid.Amount, bid.Maximum)
+	}
+
+	// The highest bidder may only raise their maximum bid.
+	if bid.Id == int32(lot.HighestBidderId) {
+		if int(bid.Maximum) > lot.HighestMaximum {
+			return nil
 		}
+
+		return bidError(auction.Reason_OWN_BID, lot.highestBid(), "you can not raise your own bid")
 	}
 
-	s.Finished = true
+	if bid.Amount < int64(lot.HighestBid)+lot.Rules.Increment {
+		return bidError(auction.Reason_TOO_LOW, lot.highestBid(), "your bid has to be at least %d higher than the biggest bid - your bid: %d - highest bid: %d", lot.Rules.Increment, bid.Amount, lot.HighestBid)
+	}
+
+	return nil
 }
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"auction/auction"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// testServer returns a server with a running auction 0, led by bidder 1 with a bid of 60 and a maximum of 80.
+func testServer(rules *auction.Rules) *server {
+	s := Server(5000, []string{"localhost:5000"}, rules, []byte("secret"), insecure.NewCredentials(), insecure.NewCredentials())
+
+	lot := Lot(0, "Test", rules)
+	lot.Started = true
+	lot.Deadline = time.Now().Add(time.Hour)
+	lot.HighestBidderId = 1
+	lot.HighestBidderName = "leader"
+	lot.HighestBid = 60
+	lot.HighestMaximum = 80
+	s.Auctions[0] = lot
+
+	return s
+}
+
+func testRules() *auction.Rules {
+	return &auction.Rules{StartingBid: 50, Duration: 120, Increment: 5, Start: auction.StartMode_FIRST_BID}
+}
+
+// reason returns the reason in the details of a failed bid, or NONE if it did not fail.
+func reason(error error) auction.Reason {
+	for _, detail := range status.Convert(error).Details() {
+		response, ok := detail.(*auction.BidResponse)
+		if ok {
+			return response.Reason
+		}
+	}
+
+	return auction.Reason_NONE
+}
+
+func TestAuction(t *testing.T) {
+	tests := []struct {
+		name           string
+		bid            *auction.BidRequest
+		reason         auction.Reason
+		highestBidder  int
+		highestBid     int
+		highestMaximum int
+	}{
+		{"outbid by the leader's maximum", &auction.BidRequest{Id: 2, Amount: 70}, auction.Reason_OUTBID, 1, 75, 80},
+		{"tie at the maximum", &auction.BidRequest{Id: 2, Amount: 80}, auction.Reason_OUTBID, 1, 80, 80},
+		{"tie with a maximum", &auction.BidRequest{Id: 2, Amount: 65, Maximum: 80}, auction.Reason_OUTBID, 1, 80, 80},
+		{"higher maximum leads by an increment", &auction.BidRequest{Id: 2, Amount: 70, Maximum: 100}, auction.Reason_NONE, 2, 85, 100},
+		{"increment capped by the maximum", &auction.BidRequest{Id: 2, Amount: 70, Maximum: 82}, auction.Reason_NONE, 2, 82, 82},
+		{"bid above the leader's maximum", &auction.BidRequest{Id: 2, Amount: 90}, auction.Reason_NONE, 2, 90, 90},
+		{"leader raises their maximum", &auction.BidRequest{Id: 1, Amount: 70, Maximum: 120}, auction.Reason_NONE, 1, 60, 120},
+		{"leader can not raise their bid", &auction.BidRequest{Id: 1, Amount: 70}, auction.Reason_OWN_BID, 1, 60, 80},
+		{"leader can not lower their maximum", &auction.BidRequest{Id: 1, Amount: 60, Maximum: 70}, auction.Reason_OWN_BID, 1, 60, 80},
+		{"too low", &auction.BidRequest{Id: 2, Amount: 62}, auction.Reason_TOO_LOW, 1, 60, 80},
+		{"maximum below the bid", &auction.BidRequest{Id: 2, Amount: 70, Maximum: 65}, auction.Reason_MAXIMUM_BELOW_BID, 1, 60, 80},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testServer(testRules())
+			lot := s.Auctions[0]
+
+			error := s.auction(test.bid, time.Now())
+			if reason(error) != test.reason {
+				t.Errorf("reason = %s, want %s (%v)", reason(error), test.reason, error)
+			}
+
+			if lot.HighestBidderId != test.highestBidder || lot.HighestBid != test.highestBid || lot.HighestMaximum != test.highestMaximum {
+				t.Errorf("bidder %d leads with %d up to %d, want bidder %d with %d up to %d",
+					lot.HighestBidderId, lot.HighestBid, lot.HighestMaximum, test.highestBidder, test.highestBid, test.highestMaximum)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(lot *lot)
+		bid    *auction.BidRequest
+		reason auction.Reason
+	}{
+		{"valid", func(lot *lot) {}, &auction.BidRequest{Id: 2, Amount: 65}, auction.Reason_NONE},
+		{"unknown auction", func(lot *lot) {}, &auction.BidRequest{Id: 2, Amount: 65, AuctionId: 7}, auction.Reason_AUCTION_NOT_FOUND},
+		{"finished", func(lot *lot) { lot.Finished = true }, &auction.BidRequest{Id: 2, Amount: 65}, auction.Reason_AUCTION_DONE},
+		{"past the deadline", func(lot *lot) { lot.Deadline = time.Now().Add(-time.Second) }, &auction.BidRequest{Id: 2, Amount: 65}, auction.Reason_AUCTION_DONE},
+		{"not started", func(lot *lot) {
+			lot.Started = false
+			lot.Rules.Start = auction.StartMode_MANUAL
+		}, &auction.BidRequest{Id: 2, Amount: 65}, auction.Reason_NOT_STARTED},
+		{"dutch", func(lot *lot) { lot.Rules.Type = auction.AuctionType_DUTCH }, &auction.BidRequest{Id: 2, Amount: 65}, auction.Reason_NOT_BIDDABLE},
+		{"less than an increment higher", func(lot *lot) {}, &auction.BidRequest{Id: 2, Amount: 64}, auction.Reason_TOO_LOW},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testServer(testRules())
+			test.change(s.Auctions[0])
+
+			error := s.validate(test.bid, time.Now())
+			if reason(error) != test.reason {
+				t.Errorf("reason = %s, want %s (%v)", reason(error), test.reason, error)
+			}
+		})
+	}
+}
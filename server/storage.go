@@ -0,0 +1,371 @@
+package main
+
+import (
+	"auction/auction"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var dataDirectory = flag.String("data", "data", "The directory the server keeps its log and snapshots in")
+
+// snapshotEntries is how many applied entries the log may hold before it is replaced by a snapshot.
+const snapshotEntries = 100
+
+// storage is an append-only log of records followed by a snapshot of the auctions it has replaced.
+type storage struct {
+	Directory string
+	Log       *os.File
+}
+
+func Storage(directory string) (*storage, error) {
+	error := os.MkdirAll(directory, 0755)
+	if error != nil {
+		return nil, error
+	}
+
+	file, error := os.OpenFile(filepath.Join(directory, "log"), os.O_CREATE|os.O_RDWR|os.O_APPEND, 0644)
+	if error != nil {
+		return nil, error
+	}
+
+	return &storage{
+		Directory: directory,
+		Log:       file,
+	}, nil
+}
+
+func (st *storage) write(record *auction.RecordMessage) error {
+	data, error := proto.Marshal(record)
+	if error != nil {
+		return error
+	}
+
+	buffer := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	_, error = st.Log.Write(append(buffer, data...))
+	if error != nil {
+		return error
+	}
+
+	return st.Log.Sync()
+}
+
+// records reads the log. A record that was only partly written when the server crashed is ignored.
+func (st *storage) records() ([]*auction.RecordMessage, error) {
+	_, error := st.Log.Seek(0, io.SeekStart)
+	if error != nil {
+		return nil, error
+	}
+
+	var records []*auction.RecordMessage
+	for {
+		var length [4]byte
+		_, error := io.ReadFull(st.Log, length[:])
+		if error == io.EOF || error == io.ErrUnexpectedEOF {
+			return records, nil
+		}
+		if error != nil {
+			return nil, error
+		}
+
+		data := make([]byte, binary.BigEndian.Uint32(length[:]))
+		_, error = io.ReadFull(st.Log, data)
+		if error == io.EOF || error == io.ErrUnexpectedEOF {
+			return records, nil
+		}
+		if error != nil {
+			return nil, error
+		}
+
+		record := &auction.RecordMessage{}
+		error = proto.Unmarshal(data, record)
+		if error != nil {
+			return nil, error
+		}
+
+		records = append(records, record)
+	}
+}
+
+func (st *storage) snapshot() (*auction.SnapshotMessage, error) {
+	data, error := os.ReadFile(filepath.Join(st.Directory, "snapshot"))
+	if errors.Is(error, os.ErrNotExist) {
+		return nil, nil
+	}
+	if error != nil {
+		return nil, error
+	}
+
+	snapshot := &auction.SnapshotMessage{}
+	return snapshot, proto.Unmarshal(data, snapshot)
+}
+
+// replace saves the snapshot and starts a new log with the records that come after it.
+// Both files are written next to the old ones and renamed over them, so a crash leaves either the old or the new files.
+func (st *storage) replace(snapshot *auction.SnapshotMessage, records []*auction.RecordMessage) error {
+	data, error := proto.Marshal(snapshot)
+	if error != nil {
+		return error
+	}
+
+	error = writeFile(filepath.Join(st.Directory, "snapshot"), data)
+	if error != nil {
+		return error
+	}
+
+	file, error := os.Create(filepath.Join(st.Directory, "log.new"))
+	if error != nil {
+		return error
+	}
+
+	old := st.Log
+	st.Log = file
+	for _, record := range records {
+		error = st.write(record)
+		if error != nil {
+			st.Log = old
+			return error
+		}
+	}
+
+	error = os.Rename(file.Name(), filepath.Join(st.Directory, "log"))
+	if error != nil {
+		st.Log = old
+		return error
+	}
+
+	old.Close()
+	return nil
+}
+
+func writeFile(name string, data []byte) error {
+	file, error := os.Create(name + ".new")
+	if error != nil {
+		return error
+	}
+	defer file.Close()
+
+	_, error = file.Write(data)
+	if error != nil {
+		return error
+	}
+
+	error = file.Sync()
+	if error != nil {
+		return error
+	}
+
+	return os.Rename(file.Name(), name)
+}
+
+// persist writes the term, the vote and the entry at the index, if there is one, to the log before the server acts on them.
+// RaftMutex must be held.
+func (s *server) persist(index int64, entry *auction.LogEntry) {
+	if s.Storage == nil {
+		return
+	}
+
+	error := s.Storage.write(&auction.RecordMessage{
+		Term:     s.Term,
+		VotedFor: int32(s.VotedFor),
+		Index:    index,
+		Entry:    entry,
+	})
+	if error != nil {
+		log.Fatalf("Failed to write the log: %s", error)
+	}
+}
+
+// recover loads the snapshot and the log from the directory, so a restarted server continues where it crashed.
+func (s *server) recover(directory string) error {
+	storage, error := Storage(directory)
+	if error != nil {
+		return error
+	}
+
+	snapshot, error := storage.snapshot()
+	if error != nil {
+		return error
+	}
+
+	if snapshot != nil {
+		s.restore(snapshot)
+	}
+
+	records, error := storage.records()
+	if error != nil {
+		return error
+	}
+
+	// An entry at an index replaces the entry there and everything after it, just like when the leader overwrites a conflicting entry.
+	for _, record := range records {
+		s.Term = record.Term
+		s.VotedFor = int(record.VotedFor)
+
+		if record.Entry != nil && record.Index > s.LogStart {
+			if record.Index <= s.lastIndex() {
+				s.Log = s.Log[:record.Index-s.LogStart]
+			}
+			s.Log = append(s.Log, record.Entry)
+		}
+	}
+
+	s.Storage = storage
+
+	log.Printf("Recovered term %d with %d entries after index %d", s.Term, s.lastIndex()-s.LogStart, s.LogStart)
+	return nil
+}
+
+// restore replaces the accounts and the auctions with the ones in the snapshot and drops the log it covers. RaftMutex must be held.
+func (s *server) restore(snapshot *auction.SnapshotMessage) {
+	s.Log = []*auction.LogEntry{{Term: snapshot.LastTerm}}
+	s.LogStart = snapshot.LastIndex
+	s.CommitIndex = snapshot.LastIndex
+	s.LastApplied = snapshot.LastIndex
+	if len(snapshot.Members) > 0 {
+		s.Configuration = snapshot.Members
+	}
+
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	old := s.Auctions
+	for _, lot := range old {
+		lot.Cancel()
+	}
+
+	s.Accounts = make(map[string]*account)
+	for _, message := range snapshot.Accounts {
+		s.Accounts[message.Name] = &account{Id: message.Id, Name: message.Name, Hash: message.Hash, Role: message.Role}
+	}
+
+	s.Auctions = make(map[int32]*lot)
+	for _, message := range snapshot.Auctions {
+		lot := Lot(message.AuctionId, message.Name, message.Rules)
+		lot.HighestBidderId = int(message.HighestBidderId)
+		lot.HighestBidderName = message.HighestBidderName
+		lot.HighestBid = int(message.HighestBid)
+		lot.HighestMaximum = int(message.HighestMaximum)
+		lot.Price = int(message.Price)
+		lot.SealedBids = message.SealedBids
+		lot.Revealing = message.Revealing
+		for _, committed := range message.Commitments {
+			lot.Commitments[committed.BidderId] = commitment{Hash: committed.Commitment, Index: committed.Index}
+		}
+		lot.StartedAt = time.UnixMilli(message.StartedAt)
+		lot.Deadline = time.UnixMilli(message.Deadline)
+		lot.Extended = message.Extended
+		lot.Started = message.Started
+		lot.Finished = message.Finished
+		lot.History = message.History
+		lot.Ledger = message.Ledger
+		for _, recorded := range message.Outcomes {
+			lot.Outcomes[requestKey{BidderId: recorded.BidderId, RequestId: recorded.RequestId}] = outcome{Response: recorded.Response, Message: recorded.Message}
+		}
+
+		// Clients watching the auction keep watching it.
+		if previous, ok := old[lot.Id]; ok {
+			lot.Watchers = previous.Watchers
+			lot.publish()
+			if lot.Finished {
+				lot.finish()
+			}
+		}
+
+		s.Auctions[lot.Id] = lot
+	}
+}
+
+// snapshot replaces the applied part of the log with a snapshot of the auctions. RaftMutex must be held.
+func (s *server) snapshot() {
+	if s.Storage == nil {
+		return
+	}
+
+	snapshot := s.capture()
+	s.Log = append([]*auction.LogEntry{{Term: snapshot.LastTerm}}, s.Log[s.LastApplied-s.LogStart+1:]...)
+	s.LogStart = s.LastApplied
+
+	s.save(snapshot)
+}
+
+// capture returns a snapshot of the accounts and the auctions after the last applied entry. RaftMutex must be held.
+func (s *server) capture() *auction.SnapshotMessage {
+	snapshot := &auction.SnapshotMessage{
+		LastIndex: s.LastApplied,
+		LastTerm:  s.entry(s.LastApplied).Term,
+		Members:   s.membersAt(s.LastApplied),
+	}
+
+	s.BidMutex.Lock()
+	defer s.BidMutex.Unlock()
+
+	for _, account := range s.Accounts {
+		snapshot.Accounts = append(snapshot.Accounts, &auction.AccountMessage{Id: account.Id, Name: account.Name, Hash: account.Hash, Role: account.Role})
+	}
+
+	for _, lot := range s.Auctions {
+		var commitments []*auction.CommitmentMessage
+		for bidderId, committed := range lot.Commitments {
+			commitments = append(commitments, &auction.CommitmentMessage{BidderId: bidderId, Commitment: committed.Hash, Index: committed.Index})
+		}
+
+		var outcomes []*auction.OutcomeMessage
+		for key, recorded := range lot.Outcomes {
+			outcomes = append(outcomes, &auction.OutcomeMessage{BidderId: key.BidderId, RequestId: key.RequestId, Response: recorded.Response, Message: recorded.Message})
+		}
+
+		snapshot.Auctions = append(snapshot.Auctions, &auction.LotMessage{
+			AuctionId:         lot.Id,
+			Name:              lot.Name,
+			Rules:             lot.Rules,
+			HighestBidderId:   int32(lot.HighestBidderId),
+			HighestBidderName: lot.HighestBidderName,
+			HighestBid:        int64(lot.HighestBid),
+			HighestMaximum:    int64(lot.HighestMaximum),
+			Price:             int64(lot.Price),
+			SealedBids:        lot.SealedBids,
+			StartedAt:         lot.StartedAt.UnixMilli(),
+			Deadline:          lot.Deadline.UnixMilli(),
+			Extended:          lot.Extended,
+			Started:           lot.Started,
+			Finished:          lot.Finished,
+			Outcomes:          outcomes,
+			History:           lot.History,
+			Ledger:            lot.Ledger,
+			Commitments:       commitments,
+			Revealing:         lot.Revealing,
+		})
+	}
+
+	return snapshot
+}
+
+// save writes the snapshot and the log after it to the storage. RaftMutex must be held.
+func (s *server) save(snapshot *auction.SnapshotMessage) {
+	if s.Storage == nil {
+		return
+	}
+
+	records := []*auction.RecordMessage{{Term: s.Term, VotedFor: int32(s.VotedFor)}}
+	for i, entry := range s.Log[1:] {
+		records = append(records, &auction.RecordMessage{
+			Term:     s.Term,
+			VotedFor: int32(s.VotedFor),
+			Index:    s.LogStart + 1 + int64(i),
+			Entry:    entry,
+		})
+	}
+
+	error := s.Storage.replace(snapshot, records)
+	if error != nil {
+		log.Fatalf("Failed to write the snapshot: %s", error)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"auction/auction"
+	"slices"
+	"testing"
+)
+
+func TestRecover(t *testing.T) {
+	directory := t.TempDir()
+
+	s := testRaft()
+	error := s.recover(directory)
+	if error != nil {
+		t.Fatal(error)
+	}
+
+	s.Term = 1
+	for i := 0; i < 3; i++ {
+		s.append(&auction.LogEntry{Term: 1})
+	}
+
+	// A new leader overwrites the entry at index 2, which drops the entry after it.
+	s.Term = 2
+	s.VotedFor = 5001
+	s.persist(2, &auction.LogEntry{Term: 2})
+
+	// The last record was only partly written when the server crashed.
+	_, error = s.Storage.Log.Write([]byte{0, 0, 0, 9, 1, 2})
+	if error != nil {
+		t.Fatal(error)
+	}
+
+	recovered := testRaft()
+	error = recovered.recover(directory)
+	if error != nil {
+		t.Fatal(error)
+	}
+
+	if recovered.Term != 2 || recovered.VotedFor != 5001 {
+		t.Errorf("term %d and vote for %d, want term 2 and vote for 5001", recovered.Term, recovered.VotedFor)
+	}
+
+	if terms := logTerms(recovered); !slices.Equal(terms, []int64{1, 2}) {
+		t.Errorf("log = %v, want [1 2]", terms)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	directory := t.TempDir()
+
+	s := testRaft()
+	error := s.recover(directory)
+	if error != nil {
+		t.Fatal(error)
+	}
+
+	s.Term = 1
+	for i := 0; i < 3; i++ {
+		s.append(&auction.LogEntry{Term: 1})
+	}
+
+	lot := Lot(0, "Test", s.Rules)
+	lot.HighestBidderId = 1
+	lot.HighestBid = 70
+	s.Auctions[0] = lot
+
+	s.CommitIndex = 2
+	s.LastApplied = 2
+	s.snapshot()
+
+	if s.LogStart != 2 || s.lastIndex() != 3 {
+		t.Errorf("log from %d to %d, want from 2 to 3", s.LogStart, s.lastIndex())
+	}
+
+	recovered := testRaft()
+	error = recovered.recover(directory)
+	if error != nil {
+		t.Fatal(error)
+	}
+
+	if recovered.LogStart != 2 || recovered.CommitIndex != 2 || recovered.LastApplied != 2 {
+		t.Errorf("log starts at %d with %d committed and %d applied, want 2 for all", recovered.LogStart, recovered.CommitIndex, recovered.LastApplied)
+	}
+
+	if terms := logTerms(recovered); !slices.Equal(terms, []int64{1}) {
+		t.Errorf("log = %v, want [1]", terms)
+	}
+
+	restored, ok := recovered.Auctions[0]
+	if !ok || restored.HighestBidderId != 1 || restored.HighestBid != 70 {
+		t.Errorf("auction 0 = %+v, want bidder 1 leading with 70", restored)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var certFile = flag.String("cert", "", "The certificate of the server. It is used for the clients and, as a client certificate, for the other servers")
+var keyFile = flag.String("key", "", "The private key of the certificate")
+var caFile = flag.String("ca", "", "The certificate authority the certificates of the servers are signed by")
+
+// loadCredentials returns the credentials the server serves with and the ones it dials the other servers with.
+// Clients only have to trust the certificate authority, but the servers prove who they are to each other with their certificates.
+// Without a certificate the connections are not encrypted.
+func loadCredentials() (credentials.TransportCredentials, credentials.TransportCredentials, error) {
+	if *certFile == "" && *keyFile == "" && *caFile == "" {
+		log.Printf("No -cert, -key and -ca were given, so the connections are not encrypted")
+		return insecure.NewCredentials(), insecure.NewCredentials(), nil
+	}
+
+	if *certFile == "" || *keyFile == "" || *caFile == "" {
+		return nil, nil, fmt.Errorf("-cert, -key and -ca have to be given together")
+	}
+
+	certificate, error := tls.LoadX509KeyPair(*certFile, *keyFile)
+	if error != nil {
+		return nil, nil, error
+	}
+
+	authority, error := os.ReadFile(*caFile)
+	if error != nil {
+		return nil, nil, error
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(authority) {
+		return nil, nil, fmt.Errorf("%s has no certificates", *caFile)
+	}
+
+	serving := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    pool,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		MinVersion:   tls.VersionTLS12,
+	})
+
+	dialing := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	})
+
+	return serving, dialing, nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"auction/auction"
+)
+
+const watchBuffer = 16
+
+func (s *server) WatchAuction(request *auction.WatchAuctionRequest, stream auction.Auction_WatchAuctionServer) error {
+	error := s.ready()
+	if error != nil {
+		return error
+	}
+
+	s.BidMutex.Lock()
+	lot, ok := s.Auctions[request.AuctionId]
+	if !ok {
+		s.BidMutex.Unlock()
+		return missing(request.AuctionId)
+	}
+
+	current := lot.result()
+	if lot.Finished {
+		s.BidMutex.Unlock()
+		return stream.Send(current)
+	}
+
+	updates := make(chan *auction.ResultResponse, watchBuffer)
+	lot.Watchers[updates] = true
+	s.BidMutex.Unlock()
+
+	defer func() {
+		s.BidMutex.Lock()
+		delete(lot.Watchers, updates)
+		s.BidMutex.Unlock()
+	}()
+
+	error = stream.Send(current)
+	if error != nil {
+		return error
+	}
+
+	for {
+		select {
+		case update, ok := <-updates:
+			// The channel is closed when the auction is over. Updates are dropped when the buffer is full,
+			// so the winner is sent here unless it was the last update.
+			if !ok {
+				if current.GetWinner() != nil {
+					return nil
+				}
+
+				// A restored snapshot replaces the lot, so it is looked up again.
+				s.BidMutex.Lock()
+				final := s.Auctions[request.AuctionId].result()
+				s.BidMutex.Unlock()
+
+				return stream.Send(final)
+			}
+
+			current = update
+			error := stream.Send(update)
+			if error != nil {
+				return error
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// publish sends the current state of the lot to its watchers. A watcher that is too slow to keep up misses the update. BidMutex must be held.
+func (l *lot) publish() {
+	update := l.result()
+
+	for watcher := range l.Watchers {
+		select {
+		case watcher <- update:
+		default:
+		}
+	}
+}